@@ -0,0 +1,163 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// Outcome describes how an Await call finished.
+type Outcome int
+
+const (
+	// OutcomeUnknown is the zero value of Outcome, which is not returned by Await. It keeps a zero AwaitResult from
+	// reporting a Ready state.
+	OutcomeUnknown Outcome = iota
+	// OutcomeReady indicates that a state satisfied all the Conditions.
+	OutcomeReady
+	// OutcomeTimedOut indicates that the context deadline passed before a state was Ready.
+	OutcomeTimedOut
+	// OutcomeFailed indicates that the state will never become Ready, either because a Condition failed permanently
+	// or because the stream of states ended.
+	OutcomeFailed
+	// OutcomeCancelled indicates that the context was cancelled before a state was Ready.
+	OutcomeCancelled
+)
+
+func (o Outcome) String() string {
+	switch o {
+	case OutcomeUnknown:
+		return "unknown"
+	case OutcomeReady:
+		return "ready"
+	case OutcomeTimedOut:
+		return "timed out"
+	case OutcomeFailed:
+		return "failed"
+	case OutcomeCancelled:
+		return "cancelled"
+	default:
+		return fmt.Sprintf("Outcome(%d)", int(o))
+	}
+}
+
+// AwaitResult specifies the result of an Await call.
+type AwaitResult struct {
+	Outcome Outcome // How the Await call finished.
+	Results Results // The Results for the last state evaluated, or nil if no state was received.
+	Err     error   // The reason the Await call finished without the state becoming Ready, if any.
+}
+
+// Ready returns true if the awaited state became Ready, false otherwise.
+func (r AwaitResult) Ready() bool {
+	return r.Outcome == OutcomeReady
+}
+
+// ProgressFunc is called with the Results of each state evaluated by Await.
+type ProgressFunc func(results Results)
+
+// ErrStatesClosed is returned by Await if the states channel is closed before a state is Ready.
+var ErrStatesClosed = errors.New("no more states to check")
+
+// Await evaluates each state received from states with the provided StateChecker until a state is Ready, a Condition
+// fails permanently, the states channel is closed, or the context is done. Each ProgressFunc is called with the
+// Results of every evaluated state.
+func Await[T any](
+	ctx context.Context, checker *StateChecker[T], states <-chan T, onProgress ...ProgressFunc,
+) AwaitResult {
+	var last Results
+	for {
+		select {
+		case <-ctx.Done():
+			return contextResult(ctx, last)
+		case state, ok := <-states:
+			if !ok {
+				return AwaitResult{Outcome: OutcomeFailed, Results: last, Err: ErrStatesClosed}
+			}
+
+			ready, results := checker.ReadyDetails(state)
+			last = results
+			for _, progress := range onProgress {
+				progress(results)
+			}
+			if ready {
+				return AwaitResult{Outcome: OutcomeReady, Results: results}
+			}
+			if results.Status() == StatusFailed {
+				return AwaitResult{Outcome: OutcomeFailed, Results: results, Err: failedError(results)}
+			}
+		}
+	}
+}
+
+// AwaitDeletion evaluates each state received from states with the provided StateChecker until a state is the zero
+// value of T or a nil pointer, which indicates that the object has been deleted. For T = interface{}, a typed nil such
+// as (*corev1.Namespace)(nil), which informers pass for deleted objects, also counts as deleted. Unlike Await, a Ready
+// state doesn't end the wait, since the Conditions of a deletion checker only describe what is holding up the deletion.
+// AwaitDeletion returns early if a Condition fails permanently, the states channel is closed, or the context is done.
+// The Results of a successful call are those of the last state evaluated before the deletion.
+func AwaitDeletion[T comparable](
+	ctx context.Context, checker *StateChecker[T], states <-chan T, onProgress ...ProgressFunc,
+) AwaitResult {
+	var deleted T
+	var last Results
+	for {
+		select {
+		case <-ctx.Done():
+			return contextResult(ctx, last)
+		case state, ok := <-states:
+			if !ok {
+				return AwaitResult{Outcome: OutcomeFailed, Results: last, Err: ErrStatesClosed}
+			}
+			if state == deleted || isNil(state) {
+				return AwaitResult{Outcome: OutcomeReady, Results: last}
+			}
+
+			_, results := checker.ReadyDetails(state)
+			last = results
+			for _, progress := range onProgress {
+				progress(results)
+			}
+			if results.Status() == StatusFailed {
+				return AwaitResult{Outcome: OutcomeFailed, Results: results, Err: failedError(results)}
+			}
+		}
+	}
+}
+
+// isNil returns true if the state is a nil pointer, including a nil pointer stored in an interface.
+func isNil(state interface{}) bool {
+	v := reflect.ValueOf(state)
+	return v.Kind() == reflect.Pointer && v.IsNil()
+}
+
+func contextResult(ctx context.Context, last Results) AwaitResult {
+	err := context.Cause(ctx)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return AwaitResult{Outcome: OutcomeTimedOut, Results: last, Err: err}
+	}
+	return AwaitResult{Outcome: OutcomeCancelled, Results: last, Err: err}
+}
+
+func failedError(results Results) error {
+	failed := results[len(results)-1]
+	if failed.Message.Empty() {
+		return errors.New(failed.Description)
+	}
+	return fmt.Errorf("%s: %s", failed.Description, failed.Message)
+}
@@ -0,0 +1,174 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func positive(n int) Result {
+	return Result{Description: "Waiting for a positive number", Ok: n > 0}
+}
+
+func notNegative(n int) Result {
+	return Result{Description: "Waiting for a non-negative number", Ok: n >= 0, Failed: n < 0}
+}
+
+func statesOf(states ...int) <-chan int {
+	ch := make(chan int, len(states))
+	for _, state := range states {
+		ch <- state
+	}
+	close(ch)
+	return ch
+}
+
+func Test_Await(t *testing.T) {
+	expired, cancelExpired := context.WithTimeout(context.Background(), 0)
+	defer cancelExpired()
+	cancelled, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	tests := []struct {
+		name          string
+		ctx           context.Context
+		states        <-chan int
+		conditions    []Condition[int]
+		expectOutcome Outcome
+		expectResults int
+	}{
+		{
+			name:          "Ready",
+			ctx:           context.Background(),
+			states:        statesOf(-1, 0, 1, 2),
+			expectOutcome: OutcomeReady,
+			expectResults: 3,
+		},
+		{
+			name:          "Failed permanently",
+			ctx:           context.Background(),
+			states:        statesOf(0, -1, 1),
+			conditions:    []Condition[int]{notNegative, positive},
+			expectOutcome: OutcomeFailed,
+			expectResults: 2,
+		},
+		{
+			name:          "States closed",
+			ctx:           context.Background(),
+			states:        statesOf(-1, 0),
+			expectOutcome: OutcomeFailed,
+			expectResults: 2,
+		},
+		{
+			name:          "Timed out",
+			ctx:           expired,
+			states:        make(chan int),
+			expectOutcome: OutcomeTimedOut,
+		},
+		{
+			name:          "Cancelled",
+			ctx:           cancelled,
+			states:        make(chan int),
+			expectOutcome: OutcomeCancelled,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			conditions := tt.conditions
+			if conditions == nil {
+				conditions = []Condition[int]{positive}
+			}
+			c := NewStateChecker(&StateCheckerArgs[int]{Conditions: conditions})
+
+			var progress []Results
+			result := Await(tt.ctx, c, tt.states, func(results Results) {
+				progress = append(progress, results)
+			})
+			assert.Equal(t, tt.expectOutcome, result.Outcome)
+			assert.Len(t, progress, tt.expectResults)
+			if tt.expectResults > 0 {
+				assert.Equal(t, progress[len(progress)-1], result.Results)
+			}
+			assert.Equal(t, tt.expectOutcome != OutcomeReady, result.Err != nil)
+		})
+	}
+}
+
+func Test_Await_DeadlineWhileWaiting(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	states := make(chan int, 1)
+	states <- 0
+	c := NewStateChecker(&StateCheckerArgs[int]{Conditions: []Condition[int]{positive}})
+
+	result := Await(ctx, c, states)
+	assert.Equal(t, OutcomeTimedOut, result.Outcome)
+	assert.Equal(t, `["pending"] Waiting for a positive number
+`, result.Results.String())
+}
+
+func Test_AwaitDeletion(t *testing.T) {
+	tests := []struct {
+		name          string
+		states        <-chan int
+		expectOutcome Outcome
+		expectResults int
+	}{
+		{
+			name:          "Deleted",
+			states:        statesOf(2, 1, 0, 3),
+			expectOutcome: OutcomeReady,
+			expectResults: 2,
+		},
+		{
+			name:          "Ready but not deleted",
+			states:        statesOf(2, 1),
+			expectOutcome: OutcomeFailed,
+			expectResults: 2,
+		},
+		{
+			name:          "Failed permanently",
+			states:        statesOf(1, -1, 0),
+			expectOutcome: OutcomeFailed,
+			expectResults: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewStateChecker(&StateCheckerArgs[int]{Conditions: []Condition[int]{notNegative}})
+
+			var progress []Results
+			result := AwaitDeletion(context.Background(), c, tt.states, func(results Results) {
+				progress = append(progress, results)
+			})
+			assert.Equal(t, tt.expectOutcome, result.Outcome)
+			assert.Len(t, progress, tt.expectResults)
+			assert.Equal(t, progress[len(progress)-1], result.Results)
+			assert.Equal(t, tt.expectOutcome != OutcomeReady, result.Err != nil)
+		})
+	}
+}
+
+func Test_AwaitResult_Zero(t *testing.T) {
+	var result AwaitResult
+	assert.False(t, result.Ready())
+	assert.Equal(t, OutcomeUnknown, result.Outcome)
+	assert.Equal(t, "unknown", result.Outcome.String())
+}
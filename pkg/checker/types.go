@@ -21,20 +21,54 @@ import (
 	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
 )
 
+// Status is the tri-state outcome of a Condition.
+type Status int
+
+const (
+	// StatusPending indicates that the Condition is not true yet, but may become true in a later state.
+	StatusPending Status = iota
+	// StatusReady indicates that the Condition is true.
+	StatusReady
+	// StatusFailed indicates that the Condition will never become true.
+	StatusFailed
+)
+
+func (s Status) String() string {
+	switch s {
+	case StatusPending:
+		return "pending"
+	case StatusReady:
+		return "done"
+	case StatusFailed:
+		return "failed"
+	default:
+		return fmt.Sprintf("Status(%d)", int(s))
+	}
+}
+
 // Result specifies the result of a Condition applied to an input object.
 type Result struct {
 	Ok          bool            // True if the Condition is true, false otherwise.
+	Failed      bool            // True if the Condition is false and will never become true.
 	Description string          // A human-readable description of the associated Condition.
 	Message     logging.Message // The message to be logged after evaluating the Condition.
+	Data        any             // Optional structured details about the Result, specific to the Condition.
 }
 
-func (r Result) String() string {
-	var s string
-	if r.Ok {
-		s = fmt.Sprintf(`["done"] %s`, r.Description)
-	} else {
-		s = fmt.Sprintf(`["pending"] %s`, r.Description)
+// Status returns the tri-state outcome of the Result.
+func (r Result) Status() Status {
+	switch {
+	case r.Ok:
+		return StatusReady
+	case r.Failed:
+		return StatusFailed
+	default:
+		return StatusPending
 	}
+}
+
+func (r Result) String() string {
+	s := fmt.Sprintf(`[%q] %s`, r.Status().String(), r.Description)
 
 	if !r.Message.Empty() {
 		s = fmt.Sprintf("%s -- %s", s, r.Message)
@@ -55,6 +89,15 @@ func (rr Results) String() string {
 	return s.String()
 }
 
+// Status returns the Status of the last Result, which is the Condition that determined the readiness of the state.
+// An empty Results is pending.
+func (rr Results) Status() Status {
+	if len(rr) == 0 {
+		return StatusPending
+	}
+	return rr[len(rr)-1].Status()
+}
+
 // Messages iterates the Results and returns a slice of the underlying Message objects. Note that these messages are
 // not cached, so each invocation of this method will allocate memory for the slice.
 func (rr Results) Messages() logging.Messages {
@@ -67,39 +110,39 @@ func (rr Results) Messages() logging.Messages {
 	return messages
 }
 
-// Condition is a function that checks a state and returns a Result.
-type Condition func(state interface{}) Result
+// Condition is a function that checks a state of type T and returns a Result.
+type Condition[T any] func(state T) Result
 
 // StateChecker holds the data required to generically implement await logic.
-type StateChecker struct {
-	conditions []Condition // Conditions that must be true for the state to be Ready.
+type StateChecker[T any] struct {
+	conditions []Condition[T] // Conditions that must be true for the state to be Ready.
 }
 
-type StateCheckerArgs struct {
-	Conditions []Condition // Conditions that must be true for the state to be Ready.
+type StateCheckerArgs[T any] struct {
+	Conditions []Condition[T] // Conditions that must be true for the state to be Ready.
 }
 
-func NewStateChecker(args *StateCheckerArgs) *StateChecker {
-	return &StateChecker{
+func NewStateChecker[T any](args *StateCheckerArgs[T]) *StateChecker[T] {
+	return &StateChecker[T]{
 		conditions: args.Conditions,
 	}
 }
 
-func (s *StateChecker) Ready(state interface{}) bool {
+func (s *StateChecker[T]) Ready(state T) bool {
 	ok, _ := s.readyDetails(state)
 	return ok
 }
 
-func (s *StateChecker) ReadyStatus(state interface{}) (bool, Result) {
+func (s *StateChecker[T]) ReadyStatus(state T) (bool, Result) {
 	ok, results := s.readyDetails(state)
 	return ok, results[len(results)-1]
 }
 
-func (s *StateChecker) ReadyDetails(state interface{}) (bool, Results) {
+func (s *StateChecker[T]) ReadyDetails(state T) (bool, Results) {
 	return s.readyDetails(state)
 }
 
-func (s *StateChecker) readyDetails(state interface{}) (bool, Results) {
+func (s *StateChecker[T]) readyDetails(state T) (bool, Results) {
 	var results Results
 
 	for _, condition := range s.conditions {
@@ -0,0 +1,64 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+)
+
+// Converter converts an untyped state into the type expected by a StateChecker.
+type Converter[T any] func(state interface{}) (T, error)
+
+// Untyped adapts a typed StateChecker for callers that still pass states as interface{}. Each state is converted
+// with the provided Converter, or with a type assertion if convert is nil. A state that cannot be converted, including
+// a nil pointer, produces a failed Result with an error Message instead of a panic, so that Await doesn't wait for a
+// state of the wrong type forever.
+func Untyped[T any](typed *StateChecker[T], convert Converter[T]) *StateChecker[interface{}] {
+	if convert == nil {
+		convert = assertType[T]
+	}
+
+	conditions := make([]Condition[interface{}], 0, len(typed.conditions))
+	for _, condition := range typed.conditions {
+		conditions = append(conditions, func(state interface{}) Result {
+			typedState, err := convert(state)
+			if err == nil && isNil(typedState) {
+				err = fmt.Errorf("unexpected nil state of type %T", state)
+			}
+			if err != nil {
+				var zero T
+				return Result{
+					Description: fmt.Sprintf("Waiting for a state of type %T", zero),
+					Failed:      true,
+					Message:     logging.ErrorMessage(err.Error()),
+				}
+			}
+			return condition(typedState)
+		})
+	}
+
+	return NewStateChecker(&StateCheckerArgs[interface{}]{Conditions: conditions})
+}
+
+func assertType[T any](state interface{}) (T, error) {
+	typed, ok := state.(T)
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("unexpected state type: got %T, want %T", state, zero)
+	}
+	return typed, nil
+}
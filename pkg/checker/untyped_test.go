@@ -0,0 +1,67 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package checker
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Untyped(t *testing.T) {
+	positivePtr := func(n *int) Result {
+		return Result{Description: "Waiting for a positive number", Ok: *n > 0}
+	}
+	one := 1
+
+	tests := []struct {
+		name          string
+		state         interface{}
+		expectOutcome Outcome
+		expectMessage string
+	}{
+		{
+			name:          "Ready",
+			state:         &one,
+			expectOutcome: OutcomeReady,
+		},
+		{
+			name:          "Wrong type",
+			state:         "one",
+			expectOutcome: OutcomeFailed,
+			expectMessage: "unexpected state type: got string, want *int",
+		},
+		{
+			name:          "Typed nil",
+			state:         (*int)(nil),
+			expectOutcome: OutcomeFailed,
+			expectMessage: "unexpected nil state of type *int",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := Untyped(NewStateChecker(&StateCheckerArgs[*int]{Conditions: []Condition[*int]{positivePtr}}), nil)
+
+			states := make(chan interface{}, 1)
+			states <- tt.state
+			result := Await(context.Background(), c, states)
+			assert.Equal(t, tt.expectOutcome, result.Outcome)
+			if tt.expectMessage != "" {
+				assert.Equal(t, tt.expectMessage, result.Results[0].Message.S)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiservice
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+)
+
+// Reasons for an APIService's Available condition to be False, as reported by the aggregator.
+const (
+	ServiceNotFoundReason      = "ServiceNotFound"
+	MissingEndpointsReason     = "MissingEndpoints"
+	FailedDiscoveryCheckReason = "FailedDiscoveryCheck"
+)
+
+// NewAPIServiceChecker returns a checker that waits for an APIService to be available, i.e., for the aggregator to
+// reach the Service that backs the API.
+func NewAPIServiceChecker() *checker.StateChecker[*apiregistrationv1.APIService] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*apiregistrationv1.APIService]{
+		Conditions: []checker.Condition[*apiregistrationv1.APIService]{apiServiceAvailable},
+	})
+}
+
+// NewUntypedAPIServiceChecker returns an apiservice checker for callers that pass states as interface{}. States may
+// be either *apiregistrationv1.APIService or *unstructured.Unstructured.
+func NewUntypedAPIServiceChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewAPIServiceChecker(), kubernetes.FromUnstructured[apiregistrationv1.APIService])
+}
+
+//
+// Conditions
+//
+
+func apiServiceAvailable(apiService *apiregistrationv1.APIService) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for APIService %q to be available", kubernetes.FullyQualifiedName(apiService))}
+
+	condition := findCondition(apiService, apiregistrationv1.Available)
+	if condition == nil {
+		return result
+	}
+	if condition.Status == apiregistrationv1.ConditionTrue {
+		result.Ok = true
+		return result
+	}
+
+	// The aggregator keeps checking the backing Service, so an unavailable APIService may still become available.
+	msg := kubernetes.ConditionMessage(condition.Reason, condition.Message)
+	if hint := reasonHint(apiService, condition.Reason); len(hint) > 0 {
+		msg += "\n" + hint
+	}
+	if condition.Status == apiregistrationv1.ConditionFalse {
+		result.Message = logging.WarningMessage(msg)
+	} else {
+		result.Message = logging.StatusMessage(msg)
+	}
+	return result
+}
+
+//
+// Helpers
+//
+
+// reasonHint explains what to check for the failure reasons that are caused by the APIService's backing Service.
+func reasonHint(apiService *apiregistrationv1.APIService, reason string) string {
+	svc := apiService.Spec.Service
+	if svc == nil {
+		return ""
+	}
+
+	fqn := kubernetes.FullyQualifiedName(&metav1.ObjectMeta{Namespace: svc.Namespace, Name: svc.Name})
+	switch reason {
+	case ServiceNotFoundReason:
+		return fmt.Sprintf("Service %q does not exist", fqn)
+	case MissingEndpointsReason:
+		return fmt.Sprintf("Service %q does not have any ready endpoints; check that its selector matches ready Pods", fqn)
+	default:
+		return ""
+	}
+}
+
+func findCondition(
+	apiService *apiregistrationv1.APIService, conditionType apiregistrationv1.APIServiceConditionType,
+) *apiregistrationv1.APIServiceCondition {
+	for i := range apiService.Status.Conditions {
+		if apiService.Status.Conditions[i].Type == conditionType {
+			return &apiService.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
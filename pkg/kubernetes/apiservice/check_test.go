@@ -0,0 +1,105 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiservice
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	apiregistrationv1 "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_apiServiceAvailable(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+		wantMessage   string
+	}{
+		{
+			name:          "APIService available",
+			testStatePath: "states/kubernetes/apiservice/available.json",
+			want:          true,
+		},
+		{
+			name:          "Local APIService",
+			testStatePath: "states/kubernetes/apiservice/local.json",
+			want:          true,
+		},
+		{
+			name:          "APIService missing endpoints",
+			testStatePath: "states/kubernetes/apiservice/missingEndpoints.json",
+			wantMessage: `[MissingEndpoints] endpoints for service/metrics-server in "kube-system" have no addresses ` +
+				`with port name "https"
+Service "kube-system/metrics-server" does not have any ready endpoints; check that its selector matches ready Pods`,
+		},
+		{
+			name:          "APIService Service not found",
+			testStatePath: "states/kubernetes/apiservice/serviceNotFound.json",
+			wantMessage: `[ServiceNotFound] service/metrics-server in "kube-system" is not present
+Service "kube-system/metrics-server" does not exist`,
+		},
+		{
+			name:          "APIService failed discovery check",
+			testStatePath: "states/kubernetes/apiservice/failedDiscoveryCheck.json",
+			wantMessage: `[FailedDiscoveryCheck] failing or missing response from ` +
+				`https://10.96.112.4:443/apis/metrics.k8s.io/v1beta1: ` +
+				`Get "https://10.96.112.4:443/apis/metrics.k8s.io/v1beta1": ` +
+				`dial tcp 10.96.112.4:443: connect: connection refused`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			apiService := test.LoadFixture[apiregistrationv1.APIService](t, tt.testStatePath)
+			got := apiServiceAvailable(apiService)
+			assert.Equal(t, tt.want, got.Ok)
+			assert.False(t, got.Failed)
+			assert.Equal(t, tt.wantMessage, got.Message.S)
+		})
+	}
+}
+
+//
+// Test APIService State Checker using recorded events.
+//
+
+func Test_APIService_Checker(t *testing.T) {
+	apiServices := test.LoadWorkflowFixtures[apiregistrationv1.APIService](t, workflowPath("available"))
+
+	result := checker.Await(context.Background(), NewAPIServiceChecker(), test.Stream(apiServices[:2]))
+	assert.False(t, result.Ready())
+	assert.Contains(t, result.Results.String(), "[FailedDiscoveryCheck]")
+
+	result = checker.Await(context.Background(), NewAPIServiceChecker(), test.Stream(apiServices))
+	assert.True(t, result.Ready())
+	assert.Equal(t, `["done"] Waiting for APIService "v1beta1.metrics.k8s.io" to be available
+`, result.Results.String())
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/apiservice/%s.json", name)
+}
@@ -0,0 +1,115 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// NewCRDChecker returns a checker that waits for a CustomResourceDefinition's names to be accepted and for it to be
+// established, i.e., for the API server to serve its custom resources. A CRD whose names conflict with another CRD, or
+// whose schema is not structural, is reported as failed.
+func NewCRDChecker() *checker.StateChecker[*apiextensionsv1.CustomResourceDefinition] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*apiextensionsv1.CustomResourceDefinition]{
+		Conditions: []checker.Condition[*apiextensionsv1.CustomResourceDefinition]{
+			crdStructuralSchema,
+			crdNamesAccepted,
+			crdEstablished,
+		},
+	})
+}
+
+// NewUntypedCRDChecker returns a crd checker for callers that pass states as interface{}. States may be either
+// *apiextensionsv1.CustomResourceDefinition or *unstructured.Unstructured.
+func NewUntypedCRDChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewCRDChecker(), kubernetes.FromUnstructured[apiextensionsv1.CustomResourceDefinition])
+}
+
+//
+// Conditions
+//
+
+func crdStructuralSchema(crd *apiextensionsv1.CustomResourceDefinition) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CustomResourceDefinition %q to have a structural schema", kubernetes.FullyQualifiedName(crd))}
+
+	condition := findCondition(crd, apiextensionsv1.NonStructuralSchema)
+	if condition != nil && condition.Status == apiextensionsv1.ConditionTrue {
+		result.Failed = true
+		result.Message = logging.ErrorMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+		return result
+	}
+
+	result.Ok = true
+	return result
+}
+
+func crdNamesAccepted(crd *apiextensionsv1.CustomResourceDefinition) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CustomResourceDefinition %q names to be accepted", kubernetes.FullyQualifiedName(crd))}
+
+	condition := findCondition(crd, apiextensionsv1.NamesAccepted)
+	switch {
+	case condition == nil:
+	case condition.Status == apiextensionsv1.ConditionTrue:
+		result.Ok = true
+	case condition.Status == apiextensionsv1.ConditionFalse:
+		// The names conflict with another CustomResourceDefinition, which has to be changed or deleted first.
+		result.Failed = true
+		result.Message = logging.ErrorMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+	default:
+		result.Message = logging.StatusMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+	}
+
+	return result
+}
+
+func crdEstablished(crd *apiextensionsv1.CustomResourceDefinition) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CustomResourceDefinition %q to be established", kubernetes.FullyQualifiedName(crd))}
+
+	condition := findCondition(crd, apiextensionsv1.Established)
+	if condition == nil {
+		return result
+	}
+	if condition.Status == apiextensionsv1.ConditionTrue {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+	return result
+}
+
+//
+// Helpers
+//
+
+func findCondition(
+	crd *apiextensionsv1.CustomResourceDefinition, conditionType apiextensionsv1.CustomResourceDefinitionConditionType,
+) *apiextensionsv1.CustomResourceDefinitionCondition {
+	for i := range crd.Status.Conditions {
+		if crd.Status.Conditions[i].Type == conditionType {
+			return &crd.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crd
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_crdNamesAccepted(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          checker.Status
+	}{
+		{"CRD installing", "states/kubernetes/crd/installing.json", checker.StatusReady},
+		{"CRD established", "states/kubernetes/crd/established.json", checker.StatusReady},
+		{"CRD with conflicting names", "states/kubernetes/crd/nameConflict.json", checker.StatusFailed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crd := test.LoadFixture[apiextensionsv1.CustomResourceDefinition](t, tt.testStatePath)
+			if got := crdNamesAccepted(crd); (checker.Results{got}).Status() != tt.want {
+				t.Errorf("crdNamesAccepted() = %v, want %v", (checker.Results{got}).Status(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_crdEstablished(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{"CRD installing", "states/kubernetes/crd/installing.json", false},
+		{"CRD established", "states/kubernetes/crd/established.json", true},
+		{"CRD with conflicting names", "states/kubernetes/crd/nameConflict.json", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crd := test.LoadFixture[apiextensionsv1.CustomResourceDefinition](t, tt.testStatePath)
+			if got := crdEstablished(crd); got.Ok != tt.want {
+				t.Errorf("crdEstablished() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_crdStructuralSchema(t *testing.T) {
+	established := test.LoadFixture[apiextensionsv1.CustomResourceDefinition](t, "states/kubernetes/crd/established.json")
+	got := crdStructuralSchema(established)
+	assert.True(t, got.Ok)
+
+	nonStructural := test.LoadFixture[apiextensionsv1.CustomResourceDefinition](t,
+		"states/kubernetes/crd/nonStructuralSchema.json")
+	got = crdStructuralSchema(nonStructural)
+	assert.True(t, got.Failed)
+	assert.Equal(t, `[Violations] [spec.versions[0].schema.openAPIV3Schema.properties[spec].type: `+
+		`Required value: must not be empty for specified object fields]`, got.Message.S)
+}
+
+//
+// Test CustomResourceDefinition State Checker using recorded events.
+//
+
+func Test_CRD_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		established  = "established"
+		nameConflict = "nameConflict"
+	)
+
+	tests := []struct {
+		name           string
+		workflowPath   string
+		expectReady    bool
+		expectFailed   bool
+		expectMessages []string
+	}{
+		{
+			name:         "CRD established",
+			workflowPath: workflow(established),
+			expectReady:  true,
+		},
+		{
+			name:           "CRD with conflicting names",
+			workflowPath:   workflow(nameConflict),
+			expectFailed:   true,
+			expectMessages: []string{`[PluralConflict] "widgets" is already in use`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			crds := test.LoadWorkflowFixtures[apiextensionsv1.CustomResourceDefinition](t, tt.workflowPath)
+
+			result := checker.Await(context.Background(), NewCRDChecker(), test.Stream(crds))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			assert.Equal(t, tt.expectFailed, result.Results.Status() == checker.StatusFailed)
+			for _, msg := range tt.expectMessages {
+				assert.Contains(t, result.Results.String(), msg)
+			}
+		})
+	}
+}
+
+func Test_CRD_Checker_Progress(t *testing.T) {
+	crdChecker := NewCRDChecker()
+
+	installing := test.LoadFixture[apiextensionsv1.CustomResourceDefinition](t, "states/kubernetes/crd/installing.json")
+	_, result := crdChecker.ReadyStatus(installing)
+	assert.Equal(t, `["pending"] Waiting for CustomResourceDefinition "widgets.example.com" to be established -- `+
+		`[Installing] the initial names have been accepted`, result.String())
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/crd/%s.json", name)
+}
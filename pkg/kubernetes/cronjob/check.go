@@ -0,0 +1,194 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/job"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+const suspendedMessage = "CronJob is suspended; no Jobs will be scheduled"
+
+// State is a CronJob together with the Jobs it spawned.
+type State struct {
+	CronJob *batchv1.CronJob
+	// Jobs that may be owned by the CronJob. Jobs that are not owned by the CronJob are ignored.
+	Jobs []*batchv1.Job
+}
+
+// NewCronJobChecker returns a checker that waits for a CronJob's most recently scheduled run to succeed. A suspended
+// CronJob is reported with a status message, but is ready as long as its last run succeeded.
+func NewCronJobChecker() *checker.StateChecker[*batchv1.CronJob] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*batchv1.CronJob]{
+		Conditions: []checker.Condition[*batchv1.CronJob]{cronJobSuspended, cronJobScheduled, cronJobLastRunSucceeded},
+	})
+}
+
+// NewUntypedCronJobChecker returns a cronjob checker for callers that pass states as interface{}. States may be
+// either *batchv1.CronJob or *unstructured.Unstructured.
+func NewUntypedCronJobChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewCronJobChecker(), kubernetes.FromUnstructured[batchv1.CronJob])
+}
+
+// NewCronJobWithJobsChecker returns a checker that evaluates the CronJob's most recent Job with the job checker, so
+// that the outcome of the last run is reported with the Job's own failure reasons. If none of the Jobs are owned by the
+// CronJob, the outcome is determined from the CronJob's status.
+func NewCronJobWithJobsChecker() *checker.StateChecker[*State] {
+	jobChecker := job.NewJobChecker()
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*State]{
+		Conditions: []checker.Condition[*State]{
+			func(state *State) checker.Result { return cronJobSuspended(state.CronJob) },
+			func(state *State) checker.Result { return cronJobScheduled(state.CronJob) },
+			func(state *State) checker.Result { return cronJobLastJobSucceeded(state, jobChecker) },
+		},
+	})
+}
+
+//
+// Conditions
+//
+
+func cronJobSuspended(cronJob *batchv1.CronJob) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Checking whether CronJob %q is suspended", kubernetes.FullyQualifiedName(cronJob)), Ok: true}
+
+	// Suspension only stops future runs, so it doesn't hold up readiness.
+	if isSuspended(cronJob) {
+		result.Message = logging.StatusMessage(suspendedMessage)
+	}
+	return result
+}
+
+func cronJobScheduled(cronJob *batchv1.CronJob) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CronJob %q to schedule a Job", kubernetes.FullyQualifiedName(cronJob))}
+
+	if cronJob.Status.LastScheduleTime != nil {
+		result.Ok = true
+		return result
+	}
+
+	if isSuspended(cronJob) {
+		result.Message = logging.StatusMessage(suspendedMessage)
+		return result
+	}
+	result.Message = logging.StatusMessage(fmt.Sprintf("Next run is due on schedule %q", cronJob.Spec.Schedule))
+	return result
+}
+
+func cronJobLastRunSucceeded(cronJob *batchv1.CronJob) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CronJob %q's last run to succeed %s", kubernetes.FullyQualifiedName(cronJob), progress(cronJob))}
+
+	if lastRunSucceeded(cronJob) {
+		result.Ok = true
+		return result
+	}
+
+	if len(cronJob.Status.Active) > 0 {
+		result.Message = logging.StatusMessage(activeJobsMessage(cronJob))
+	}
+	return result
+}
+
+func cronJobLastJobSucceeded(state *State, jobChecker *checker.StateChecker[*batchv1.Job]) checker.Result {
+	last := LastJob(state.CronJob, state.Jobs)
+	if last == nil {
+		return cronJobLastRunSucceeded(state.CronJob)
+	}
+
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for CronJob %q's last Job %q to succeed %s",
+		kubernetes.FullyQualifiedName(state.CronJob), last.Name, progress(state.CronJob))}
+
+	ready, details := jobChecker.ReadyDetails(last)
+	if ready {
+		result.Ok = true
+		return result
+	}
+
+	jobResult := details[len(details)-1]
+	msg := jobResult.Description
+	if !jobResult.Message.Empty() {
+		msg = fmt.Sprintf("%s: %s", msg, jobResult.Message)
+	}
+	// A failed run is reported, but the CronJob may still succeed on its next scheduled run.
+	if jobResult.Failed {
+		result.Message = logging.WarningMessage(msg)
+	} else {
+		result.Message = logging.StatusMessage(msg)
+	}
+	return result
+}
+
+//
+// Helpers
+//
+
+func isSuspended(cronJob *batchv1.CronJob) bool {
+	return cronJob.Spec.Suspend != nil && *cronJob.Spec.Suspend
+}
+
+// LastJob returns the most recently created Job that is controlled by the CronJob, or nil if there is none.
+func LastJob(cronJob *batchv1.CronJob, jobs []*batchv1.Job) *batchv1.Job {
+	var last *batchv1.Job
+	for _, j := range jobs {
+		if !kubernetes.IsControlledBy(j, cronJob) {
+			continue
+		}
+		if last == nil || last.CreationTimestamp.Before(&j.CreationTimestamp) ||
+			(last.CreationTimestamp.Equal(&j.CreationTimestamp) && last.Name < j.Name) {
+			last = j
+		}
+	}
+
+	return last
+}
+
+// lastRunSucceeded returns true if the most recently scheduled Job succeeded, i.e., the CronJob has no active Jobs
+// and its last successful time is not before its last schedule time.
+func lastRunSucceeded(cronJob *batchv1.CronJob) bool {
+	status := cronJob.Status
+	if len(status.Active) > 0 || status.LastSuccessfulTime == nil || status.LastScheduleTime == nil {
+		return false
+	}
+
+	return !status.LastSuccessfulTime.Before(status.LastScheduleTime)
+}
+
+func progress(cronJob *batchv1.CronJob) string {
+	lastSchedule := "never"
+	if cronJob.Status.LastScheduleTime != nil {
+		lastSchedule = cronJob.Status.LastScheduleTime.UTC().Format(time.RFC3339)
+	}
+
+	return fmt.Sprintf("(Last schedule: %s | Active: %d)", lastSchedule, len(cronJob.Status.Active))
+}
+
+func activeJobsMessage(cronJob *batchv1.CronJob) string {
+	names := make([]string, len(cronJob.Status.Active))
+	for i, ref := range cronJob.Status.Active {
+		names[i] = fmt.Sprintf("%q", ref.Name)
+	}
+
+	return fmt.Sprintf("Active Jobs: %s", strings.Join(names, ", "))
+}
@@ -0,0 +1,299 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cronjob
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/job"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_cronJobSuspended(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		wantMessage   logging.Message
+	}{
+		{
+			"CronJob created",
+			"states/kubernetes/cronjob/created.json",
+			logging.Message{},
+		},
+		{
+			"CronJob suspended",
+			"states/kubernetes/cronjob/suspended.json",
+			logging.StatusMessage("CronJob is suspended; no Jobs will be scheduled"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := cronJobSuspended(test.LoadFixture[batchv1.CronJob](t, tt.testStatePath))
+			assert.True(t, result.Ok)
+			assert.Equal(t, tt.wantMessage, result.Message)
+		})
+	}
+}
+
+func Test_cronJobScheduled(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{
+			"CronJob created",
+			"states/kubernetes/cronjob/created.json",
+			false,
+		},
+		{
+			"CronJob with an active Job",
+			"states/kubernetes/cronjob/active.json",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := test.LoadFixture[batchv1.CronJob](t, tt.testStatePath)
+			if got := cronJobScheduled(cronJob); got.Ok != tt.want {
+				t.Errorf("cronJobScheduled() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cronJobLastRunSucceeded(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{
+			"CronJob created",
+			"states/kubernetes/cronjob/created.json",
+			false,
+		},
+		{
+			"CronJob with an active Job",
+			"states/kubernetes/cronjob/active.json",
+			false,
+		},
+		{
+			"CronJob whose last run succeeded",
+			"states/kubernetes/cronjob/succeeded.json",
+			true,
+		},
+		{
+			"CronJob whose last run failed",
+			"states/kubernetes/cronjob/lastRunFailed.json",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJob := test.LoadFixture[batchv1.CronJob](t, tt.testStatePath)
+			if got := cronJobLastRunSucceeded(cronJob); got.Ok != tt.want {
+				t.Errorf("cronJobLastRunSucceeded() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_cronJobLastJobSucceeded(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		jobPaths      []string
+		want          bool
+		wantMessage   string
+	}{
+		{
+			"CronJob with a running Job",
+			"states/kubernetes/cronjob/active.json",
+			[]string{"states/kubernetes/cronjob/jobRunning.json"},
+			false,
+			`Waiting for Job "nightly-export-29561880" to succeed (Active: 1 | Succeeded: 0 | Failed: 0)`,
+		},
+		{
+			"CronJob with a succeeded Job",
+			"states/kubernetes/cronjob/succeeded.json",
+			[]string{"states/kubernetes/cronjob/jobSucceeded.json"},
+			true,
+			"",
+		},
+		{
+			"CronJob whose most recent Job failed",
+			"states/kubernetes/cronjob/lastRunFailed.json",
+			[]string{"states/kubernetes/cronjob/jobFailed.json", "states/kubernetes/cronjob/jobSucceeded.json"},
+			false,
+			`Waiting for Job "nightly-export-29563320" to succeed (Active: 0 | Succeeded: 0 | Failed: 3): [BackoffLimitExceeded] ` +
+				`Job has reached the specified backoff limit`,
+		},
+		{
+			"CronJob without Jobs falls back to its status",
+			"states/kubernetes/cronjob/succeeded.json",
+			nil,
+			true,
+			"",
+		},
+	}
+	jobChecker := job.NewJobChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &State{CronJob: test.LoadFixture[batchv1.CronJob](t, tt.testStatePath)}
+			for _, path := range tt.jobPaths {
+				state.Jobs = append(state.Jobs, test.LoadFixture[batchv1.Job](t, path))
+			}
+			got := cronJobLastJobSucceeded(state, jobChecker)
+			assert.Equal(t, tt.want, got.Ok)
+			assert.Equal(t, tt.wantMessage, got.Message.S)
+			assert.False(t, got.Failed)
+		})
+	}
+}
+
+func Test_LastJob(t *testing.T) {
+	cronJob := test.LoadFixture[batchv1.CronJob](t, "states/kubernetes/cronjob/lastRunFailed.json")
+	succeeded := test.LoadFixture[batchv1.Job](t, "states/kubernetes/cronjob/jobSucceeded.json")
+	failed := test.LoadFixture[batchv1.Job](t, "states/kubernetes/cronjob/jobFailed.json")
+
+	assert.Nil(t, LastJob(cronJob, nil))
+	assert.Equal(t, failed, LastJob(cronJob, []*batchv1.Job{succeeded, failed}))
+	assert.Equal(t, failed, LastJob(cronJob, []*batchv1.Job{failed, succeeded}))
+
+	orphan := failed.DeepCopy()
+	orphan.OwnerReferences = nil
+	assert.Equal(t, succeeded, LastJob(cronJob, []*batchv1.Job{succeeded, orphan}))
+}
+
+//
+// Test CronJob State Checker using recorded events.
+//
+
+func Test_CronJob_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		succeeded = "succeeded"
+	)
+
+	tests := []struct {
+		name         string
+		workflowPath string
+		expectReady  bool
+	}{
+		{"CronJob whose first run succeeded", workflow(succeeded), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cronJobs := test.LoadWorkflowFixtures[batchv1.CronJob](t, tt.workflowPath)
+
+			result := checker.Await(context.Background(), NewCronJobChecker(), test.Stream(cronJobs))
+			assert.Equal(t, tt.expectReady, result.Ready())
+		})
+	}
+}
+
+func Test_CronJob_Checker_Progress(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          string
+	}{
+		{
+			"CronJob created",
+			"states/kubernetes/cronjob/created.json",
+			`["pending"] Waiting for CronJob "nightly-export" to schedule a Job -- Next run is due on schedule "0 2 * * *"`,
+		},
+		{
+			"CronJob with an active Job",
+			"states/kubernetes/cronjob/active.json",
+			`["pending"] Waiting for CronJob "nightly-export"'s last run to succeed ` +
+				`(Last schedule: 2026-03-16T02:00:00Z | Active: 1) -- Active Jobs: "nightly-export-29561880"`,
+		},
+	}
+	cronJobChecker := NewCronJobChecker()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, result := cronJobChecker.ReadyStatus(test.LoadFixture[batchv1.CronJob](t, tt.testStatePath))
+			assert.Equal(t, tt.want, result.String())
+		})
+	}
+}
+
+func Test_CronJob_Checker_Suspended(t *testing.T) {
+	cronJobChecker := NewCronJobChecker()
+
+	// A suspended CronJob whose last run succeeded is ready, and reports the suspension.
+	suspended := test.LoadFixture[batchv1.CronJob](t, "states/kubernetes/cronjob/suspended.json")
+	result := checker.Await(context.Background(), cronJobChecker, test.Stream([]*batchv1.CronJob{suspended}))
+	assert.True(t, result.Ready())
+	assert.Equal(t, logging.Messages{logging.StatusMessage("CronJob is suspended; no Jobs will be scheduled")},
+		result.Results.Messages())
+
+	// A suspended CronJob that never ran will not schedule a Job until it is resumed.
+	neverRun := test.LoadFixture[batchv1.CronJob](t, "states/kubernetes/cronjob/created.json")
+	neverRun.Spec.Suspend = ptr(true)
+	ready, status := cronJobChecker.ReadyStatus(neverRun)
+	assert.False(t, ready)
+	assert.Equal(t, `["pending"] Waiting for CronJob "nightly-export" to schedule a Job -- `+
+		`CronJob is suspended; no Jobs will be scheduled`, status.String())
+}
+
+func Test_CronJob_With_Jobs_Checker(t *testing.T) {
+	cronJobChecker := NewCronJobWithJobsChecker()
+
+	ready, result := cronJobChecker.ReadyStatus(&State{
+		CronJob: test.LoadFixture[batchv1.CronJob](t, "states/kubernetes/cronjob/lastRunFailed.json"),
+		Jobs: []*batchv1.Job{
+			test.LoadFixture[batchv1.Job](t, "states/kubernetes/cronjob/jobSucceeded.json"),
+			test.LoadFixture[batchv1.Job](t, "states/kubernetes/cronjob/jobFailed.json"),
+		},
+	})
+	assert.False(t, ready)
+	assert.False(t, result.Failed)
+	assert.Equal(t, `["pending"] Waiting for CronJob "nightly-export"'s last Job "nightly-export-29563320" to succeed `+
+		`(Last schedule: 2026-03-17T02:00:00Z | Active: 0) -- Waiting for Job "nightly-export-29563320" to succeed `+
+		`(Active: 0 | Succeeded: 0 | Failed: 3): [BackoffLimitExceeded] Job has reached the specified backoff limit`, result.String())
+
+	ready, _ = cronJobChecker.ReadyStatus(&State{
+		CronJob: test.LoadFixture[batchv1.CronJob](t, "states/kubernetes/cronjob/succeeded.json"),
+		Jobs:    []*batchv1.Job{test.LoadFixture[batchv1.Job](t, "states/kubernetes/cronjob/jobSucceeded.json")},
+	})
+	assert.True(t, ready)
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/cronjob/%s.json", name)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
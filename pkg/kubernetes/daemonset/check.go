@@ -0,0 +1,179 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func NewDaemonSetChecker() *checker.StateChecker[*appsv1.DaemonSet] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*appsv1.DaemonSet]{
+		Conditions: []checker.Condition[*appsv1.DaemonSet]{
+			daemonSetObserved,
+			daemonSetScheduled,
+			daemonSetUpdated,
+			daemonSetAvailable,
+		},
+	})
+}
+
+// NewUntypedDaemonSetChecker returns a daemonset checker for callers that pass states as interface{}. States may be
+// either *appsv1.DaemonSet or *unstructured.Unstructured.
+func NewUntypedDaemonSetChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewDaemonSetChecker(), kubernetes.FromUnstructured[appsv1.DaemonSet])
+}
+
+//
+// Conditions
+//
+
+func daemonSetObserved(ds *appsv1.DaemonSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for DaemonSet %q to be observed by the controller", kubernetes.FullyQualifiedName(ds))}
+
+	if ds.Status.ObservedGeneration >= ds.Generation {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func daemonSetScheduled(ds *appsv1.DaemonSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for DaemonSet %q Pods to be scheduled (Scheduled: %d/%d)",
+		kubernetes.FullyQualifiedName(ds), ds.Status.CurrentNumberScheduled, ds.Status.DesiredNumberScheduled)}
+
+	if ds.Status.NumberMisscheduled > 0 {
+		result.Message = logging.WarningMessage(fmt.Sprintf(
+			"%s running on nodes that should not run the daemon Pod", podsAre(ds.Status.NumberMisscheduled)))
+		return result
+	}
+	if ds.Status.CurrentNumberScheduled >= ds.Status.DesiredNumberScheduled {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func daemonSetUpdated(ds *appsv1.DaemonSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for DaemonSet %q Pods to be updated (Updated: %d/%d)",
+		kubernetes.FullyQualifiedName(ds), ds.Status.UpdatedNumberScheduled, ds.Status.DesiredNumberScheduled)}
+
+	if ds.Status.UpdatedNumberScheduled >= ds.Status.DesiredNumberScheduled {
+		result.Ok = true
+		return result
+	}
+
+	switch ds.Spec.UpdateStrategy.Type {
+	case appsv1.OnDeleteDaemonSetStrategyType:
+		// The controller leaves the old Pods in place until they are deleted by hand, so don't wait for the update.
+		result.Ok = true
+		result.Message = logging.StatusMessage(
+			"Delete the remaining Pods to update them with the OnDelete strategy")
+	case appsv1.RollingUpdateDaemonSetStrategyType:
+		maxSurge, maxUnavailable := rollingUpdateBudget(ds)
+		unavailable := ds.Status.DesiredNumberScheduled - ds.Status.NumberAvailable
+		if unavailable > maxUnavailable {
+			msg := fmt.Sprintf("%s unavailable, more than the %d allowed by maxUnavailable",
+				podsAre(unavailable), maxUnavailable)
+			if maxSurge == 0 {
+				// Without surge, the controller only deletes old Pods while the number of unavailable Pods is within
+				// maxUnavailable. With surge, it still replaces old Pods that are unavailable.
+				msg += "; the rolling update will not proceed until they become available"
+			}
+			result.Message = logging.WarningMessage(msg)
+			return result
+		}
+		result.Message = logging.StatusMessage(rollingUpdateProgress(maxSurge, maxUnavailable))
+	}
+
+	return result
+}
+
+func daemonSetAvailable(ds *appsv1.DaemonSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for DaemonSet %q Pods to become available (Available: %d/%d)",
+		kubernetes.FullyQualifiedName(ds), ds.Status.NumberAvailable, ds.Status.DesiredNumberScheduled)}
+
+	if ds.Status.NumberAvailable >= ds.Status.DesiredNumberScheduled {
+		result.Ok = true
+	}
+
+	return result
+}
+
+//
+// Helpers
+//
+
+// rollingUpdateBudget returns the number of nodes that the controller surges new Pods onto, and the number of Pods
+// that may be unavailable, during a rolling update. Percentages are rounded up, and maxUnavailable is 1 if both are 0,
+// like the DaemonSet controller does.
+func rollingUpdateBudget(ds *appsv1.DaemonSet) (int32, int32) {
+	maxSurge, maxUnavailable := intstr.FromInt32(0), intstr.FromInt32(1)
+	if rollingUpdate := ds.Spec.UpdateStrategy.RollingUpdate; rollingUpdate != nil {
+		if rollingUpdate.MaxSurge != nil {
+			maxSurge = *rollingUpdate.MaxSurge
+		}
+		if rollingUpdate.MaxUnavailable != nil {
+			maxUnavailable = *rollingUpdate.MaxUnavailable
+		}
+	}
+
+	desired := int(ds.Status.DesiredNumberScheduled)
+	surge, err := intstr.GetScaledValueFromIntOrPercent(&maxSurge, desired, true)
+	if err != nil {
+		surge = 0
+	}
+	unavailable, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, desired, true)
+	if err != nil {
+		unavailable = 1
+	}
+	if surge == 0 && unavailable == 0 {
+		unavailable = 1
+	}
+
+	return int32(surge), int32(unavailable)
+}
+
+// rollingUpdateProgress describes how the controller replaces Pods during a rolling update.
+func rollingUpdateProgress(maxSurge, maxUnavailable int32) string {
+	if maxSurge > 0 {
+		return fmt.Sprintf("Rolling update in progress: new Pods are surged onto up to %s before old Pods are removed",
+			nodes(maxSurge))
+	}
+	return fmt.Sprintf("Rolling update in progress: old Pods are replaced on up to %s at a time", nodes(maxUnavailable))
+}
+
+func podsAre(n int32) string {
+	if n == 1 {
+		return "1 Pod is"
+	}
+	return fmt.Sprintf("%d Pods are", n)
+}
+
+func nodes(n int32) string {
+	if n == 1 {
+		return "1 node"
+	}
+	return fmt.Sprintf("%d nodes", n)
+}
@@ -0,0 +1,301 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package daemonset
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+//
+// Test Conditions
+//
+
+func Test_daemonSetConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     checker.Condition[*appsv1.DaemonSet]
+		testStatePath string
+		want          bool
+	}{
+		{
+			"DaemonSet observed",
+			daemonSetObserved,
+			"states/kubernetes/daemonset/ready.json",
+			true,
+		},
+		{
+			"DaemonSet unobserved",
+			daemonSetObserved,
+			"states/kubernetes/daemonset/unobserved.json",
+			false,
+		},
+		{
+			"DaemonSet scheduled",
+			daemonSetScheduled,
+			"states/kubernetes/daemonset/ready.json",
+			true,
+		},
+		{
+			"DaemonSet unscheduled",
+			daemonSetScheduled,
+			"states/kubernetes/daemonset/unscheduled.json",
+			false,
+		},
+		{
+			"DaemonSet misscheduled",
+			daemonSetScheduled,
+			"states/kubernetes/daemonset/misscheduled.json",
+			false,
+		},
+		{
+			"DaemonSet updated",
+			daemonSetUpdated,
+			"states/kubernetes/daemonset/unavailable.json",
+			true,
+		},
+		{
+			"DaemonSet updating",
+			daemonSetUpdated,
+			"states/kubernetes/daemonset/updating.json",
+			false,
+		},
+		{
+			"DaemonSet OnDelete strategy",
+			daemonSetUpdated,
+			"states/kubernetes/daemonset/onDelete.json",
+			true,
+		},
+		{
+			"DaemonSet available",
+			daemonSetAvailable,
+			"states/kubernetes/daemonset/ready.json",
+			true,
+		},
+		{
+			"DaemonSet unavailable",
+			daemonSetAvailable,
+			"states/kubernetes/daemonset/unavailable.json",
+			false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := test.LoadFixture[appsv1.DaemonSet](t, tt.testStatePath)
+			if got := tt.condition(ds); got.Ok != tt.want {
+				t.Errorf("Ok = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_daemonSetUpdated_RollingUpdate(t *testing.T) {
+	tests := []struct {
+		name           string
+		maxSurge       intstr.IntOrString
+		maxUnavailable intstr.IntOrString
+		available      int32
+		want           logging.Message
+	}{
+		{
+			"Within maxUnavailable",
+			intstr.FromInt32(0), intstr.FromInt32(1), 2,
+			logging.StatusMessage("Rolling update in progress: old Pods are replaced on up to 1 node at a time"),
+		},
+		{
+			"Percentage of maxUnavailable",
+			intstr.FromInt32(0), intstr.FromString("50%"), 1,
+			logging.StatusMessage("Rolling update in progress: old Pods are replaced on up to 2 nodes at a time"),
+		},
+		{
+			"More unavailable Pods than maxUnavailable allows",
+			intstr.FromInt32(0), intstr.FromInt32(1), 1,
+			logging.WarningMessage("2 Pods are unavailable, more than the 1 allowed by maxUnavailable; " +
+				"the rolling update will not proceed until they become available"),
+		},
+		{
+			"Surge with all nodes available",
+			intstr.FromInt32(1), intstr.FromInt32(0), 3,
+			logging.StatusMessage("Rolling update in progress: new Pods are surged onto up to 1 node before old Pods are removed"),
+		},
+		{
+			"Surge with an unavailable node",
+			intstr.FromInt32(1), intstr.FromInt32(0), 2,
+			logging.WarningMessage("1 Pod is unavailable, more than the 0 allowed by maxUnavailable"),
+		},
+		{
+			"Percentage of maxSurge with unavailable nodes",
+			intstr.FromString("10%"), intstr.FromInt32(0), 1,
+			logging.WarningMessage("2 Pods are unavailable, more than the 0 allowed by maxUnavailable"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := test.LoadFixture[appsv1.DaemonSet](t, "states/kubernetes/daemonset/updating.json")
+			ds.Spec.UpdateStrategy.RollingUpdate = &appsv1.RollingUpdateDaemonSet{
+				MaxSurge:       &tt.maxSurge,
+				MaxUnavailable: &tt.maxUnavailable,
+			}
+			ds.Status.NumberAvailable = tt.available
+
+			result := daemonSetUpdated(ds)
+			assert.False(t, result.Ok)
+			assert.Equal(t, tt.want, result.Message)
+		})
+	}
+}
+
+//
+// Test DaemonSet State Checker using recorded events.
+//
+
+func Test_DaemonSet_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		added                 = "added"
+		createSuccess         = "createSuccess"
+		misscheduled          = "misscheduled"
+		onDelete              = "onDelete"
+		rollingUpdate         = "rollingUpdate"
+		rollingUpdateMaxSurge = "rollingUpdateMaxSurge"
+	)
+
+	tests := []struct {
+		name          string
+		workflowPaths []string
+		expectReady   bool
+		expectMessage string
+	}{
+		{
+			name:          "DaemonSet added but not observed",
+			workflowPaths: []string{workflow(added)},
+			expectReady:   false,
+			expectMessage: `["pending"] Waiting for DaemonSet "kube-system/node-agent" to be observed by the controller
+`,
+		},
+		{
+			name:          "DaemonSet create success",
+			workflowPaths: []string{workflow(createSuccess)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for DaemonSet "kube-system/node-agent" to be observed by the controller
+["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to be scheduled (Scheduled: 3/3)
+["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 3/3)
+["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to become available (Available: 3/3)
+`,
+		},
+		{
+			name:          "DaemonSet rolling update success",
+			workflowPaths: []string{workflow(rollingUpdate)},
+			expectReady:   true,
+		},
+		{
+			name:          "DaemonSet rolling update with maxSurge success",
+			workflowPaths: []string{workflow(rollingUpdateMaxSurge)},
+			expectReady:   true,
+		},
+		{
+			name:          "DaemonSet OnDelete update",
+			workflowPaths: []string{workflow(onDelete)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 0/3) -- Delete the remaining Pods to update them with the OnDelete strategy
+`,
+		},
+		{
+			name:          "DaemonSet misscheduled Pods removed",
+			workflowPaths: []string{workflow(misscheduled)},
+			expectReady:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsStates := test.LoadWorkflowFixtures[appsv1.DaemonSet](t, tt.workflowPaths...)
+			result := checker.Await(context.Background(), NewDaemonSetChecker(), test.Stream(dsStates))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			if tt.expectMessage != "" {
+				assert.Contains(t, result.Results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_DaemonSet_Checker_Progress(t *testing.T) {
+	tests := []struct {
+		name           string
+		workflowPath   string
+		expectStatuses []string
+	}{
+		{
+			name:         "Rolling update with maxUnavailable",
+			workflowPath: workflowPath("rollingUpdate"),
+			expectStatuses: []string{
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" to be observed by the controller`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 0/3) -- Rolling update in progress: old Pods are replaced on up to 1 node at a time`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 1/3) -- Rolling update in progress: old Pods are replaced on up to 1 node at a time`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 2/3) -- Rolling update in progress: old Pods are replaced on up to 1 node at a time`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to become available (Available: 2/3)`,
+				`["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to become available (Available: 3/3)`,
+			},
+		},
+		{
+			name:         "Rolling update with maxSurge",
+			workflowPath: workflowPath("rollingUpdateMaxSurge"),
+			expectStatuses: []string{
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" to be observed by the controller`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 0/3) -- Rolling update in progress: new Pods are surged onto up to 1 node before old Pods are removed`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 1/3) -- Rolling update in progress: new Pods are surged onto up to 1 node before old Pods are removed`,
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be updated (Updated: 2/3) -- Rolling update in progress: new Pods are surged onto up to 1 node before old Pods are removed`,
+				`["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to become available (Available: 3/3)`,
+			},
+		},
+		{
+			name:         "Misscheduled Pods",
+			workflowPath: workflowPath("misscheduled"),
+			expectStatuses: []string{
+				`["pending"] Waiting for DaemonSet "kube-system/node-agent" Pods to be scheduled (Scheduled: 2/2) -- 1 Pod is running on nodes that should not run the daemon Pod`,
+				`["done"] Waiting for DaemonSet "kube-system/node-agent" Pods to become available (Available: 2/2)`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dsChecker := NewDaemonSetChecker()
+
+			var statuses []string
+			for _, ds := range test.LoadWorkflowFixtures[appsv1.DaemonSet](t, tt.workflowPath) {
+				_, result := dsChecker.ReadyStatus(ds)
+				statuses = append(statuses, result.String())
+			}
+			assert.Equal(t, tt.expectStatuses, statuses)
+		})
+	}
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/daemonset/%s.json", name)
+}
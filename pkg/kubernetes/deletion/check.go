@@ -0,0 +1,155 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// NewDeletionChecker returns a checker that describes why an object of any kind has not been deleted yet: either its
+// deletion has not been requested, or finalizers are holding it up. It is intended to be used with
+// checker.AwaitDeletion, which waits for the object to be gone rather than for the checker to be Ready.
+func NewDeletionChecker() *checker.StateChecker[*unstructured.Unstructured] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*unstructured.Unstructured]{
+		Conditions: []checker.Condition[*unstructured.Unstructured]{deletionRequested, finalizersRemoved},
+	})
+}
+
+// NewNamespaceDeletionChecker returns a checker that describes why a Namespace has not been deleted yet. In addition to
+// the object's own finalizers, it reports the namespace controller's progress deleting the Namespace's content, and
+// the `kubernetes` finalizer that is removed once the content is gone.
+func NewNamespaceDeletionChecker() *checker.StateChecker[*corev1.Namespace] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*corev1.Namespace]{
+		Conditions: []checker.Condition[*corev1.Namespace]{
+			namespaceDeletionRequested, namespaceContentDeleted, namespaceFinalizersRemoved,
+		},
+	})
+}
+
+// NewUntypedNamespaceDeletionChecker returns a Namespace deletion checker that accepts *corev1.Namespace or
+// *unstructured.Unstructured states as interface{}.
+func NewUntypedNamespaceDeletionChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewNamespaceDeletionChecker(), kubernetes.FromUnstructured[corev1.Namespace])
+}
+
+//
+// Conditions
+//
+
+func deletionRequested(obj *unstructured.Unstructured) checker.Result {
+	return checker.Result{
+		Description: fmt.Sprintf(
+			"Waiting for %s %q to be marked for deletion", obj.GetKind(), kubernetes.FullyQualifiedName(obj)),
+		Ok: obj.GetDeletionTimestamp() != nil,
+	}
+}
+
+func finalizersRemoved(obj *unstructured.Unstructured) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for %s %q finalizers to be removed", obj.GetKind(), kubernetes.FullyQualifiedName(obj))}
+
+	finalizers := obj.GetFinalizers()
+	if len(finalizers) == 0 {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(blockedByFinalizers(finalizers))
+	return result
+}
+
+func namespaceDeletionRequested(ns *corev1.Namespace) checker.Result {
+	return checker.Result{
+		Description: fmt.Sprintf("Waiting for Namespace %q to be marked for deletion", ns.Name),
+		Ok:          ns.DeletionTimestamp != nil || ns.Status.Phase == corev1.NamespaceTerminating,
+	}
+}
+
+func namespaceContentDeleted(ns *corev1.Namespace) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf("Waiting for Namespace %q content to be deleted", ns.Name)}
+
+	var failures, remaining error
+	for _, condition := range ns.Status.Conditions {
+		if condition.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch condition.Type {
+		case corev1.NamespaceDeletionDiscoveryFailure,
+			corev1.NamespaceDeletionGVParsingFailure,
+			corev1.NamespaceDeletionContentFailure:
+			failures = errors.Join(failures, namespaceConditionError(condition))
+		case corev1.NamespaceContentRemaining,
+			corev1.NamespaceFinalizersRemaining:
+			remaining = errors.Join(remaining, namespaceConditionError(condition))
+		}
+	}
+
+	switch {
+	case failures != nil:
+		result.Message = logging.WarningMessage(errors.Join(failures, remaining).Error())
+	case remaining != nil:
+		result.Message = logging.StatusMessage(remaining.Error())
+	default:
+		result.Ok = true
+	}
+
+	return result
+}
+
+func namespaceFinalizersRemoved(ns *corev1.Namespace) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf("Waiting for Namespace %q finalizers to be removed", ns.Name)}
+
+	// Copy the finalizers, since appending to ns.Finalizers could write to the caller's backing array.
+	finalizers := append([]string(nil), ns.Finalizers...)
+	for _, finalizer := range ns.Spec.Finalizers {
+		finalizers = append(finalizers, string(finalizer))
+	}
+	if len(finalizers) == 0 {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(blockedByFinalizers(finalizers))
+	return result
+}
+
+//
+// Helpers
+//
+
+// blockedByFinalizers returns a message that names the finalizers holding up a deletion.
+func blockedByFinalizers(finalizers []string) string {
+	quoted := make([]string, len(finalizers))
+	for i, finalizer := range finalizers {
+		quoted[i] = fmt.Sprintf("%q", finalizer)
+	}
+	if len(finalizers) == 1 {
+		return fmt.Sprintf("Deletion is blocked by finalizer %s", quoted[0])
+	}
+
+	return fmt.Sprintf("Deletion is blocked by finalizers %s", strings.Join(quoted, ", "))
+}
+
+func namespaceConditionError(condition corev1.NamespaceCondition) error {
+	return errors.New(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+}
@@ -0,0 +1,285 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deletion
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+//
+// Test Conditions
+//
+
+func Test_finalizersRemoved(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+		wantMessage   logging.Message
+	}{
+		{
+			"Object with a finalizer",
+			"states/kubernetes/deletion/bucketFinalizerRemaining.json",
+			false,
+			logging.StatusMessage(`Deletion is blocked by finalizer "finalizer.managedresource.crossplane.io"`),
+		},
+		{
+			"Object without finalizers",
+			"states/kubernetes/deletion/bucketFinalized.json",
+			true,
+			logging.Message{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := finalizersRemoved(test.LoadFixture[unstructured.Unstructured](t, tt.testStatePath))
+			assert.Equal(t, tt.want, result.Ok)
+			assert.Equal(t, tt.wantMessage, result.Message)
+		})
+	}
+}
+
+func Test_namespaceContentDeleted(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+		wantMessage   logging.Message
+	}{
+		{
+			"Namespace without conditions",
+			"states/kubernetes/deletion/namespaceActive.json",
+			true,
+			logging.Message{},
+		},
+		{
+			"Namespace with remaining content",
+			"states/kubernetes/deletion/namespaceContentRemaining.json",
+			false,
+			logging.StatusMessage("[SomeResourcesRemain] Some resources are remaining: " +
+				"certificates.cert-manager.io has 1 resource instances, pods. has 2 resource instances\n" +
+				"[SomeFinalizersRemain] Some content in the namespace has finalizers remaining: " +
+				"finalizer.acme.cert-manager.io in 1 resource instances"),
+		},
+		{
+			"Namespace with content deletion failure",
+			"states/kubernetes/deletion/namespaceContentFailure.json",
+			false,
+			logging.WarningMessage("[ContentDeletionFailed] Failed to delete all resource types, 1 remaining: " +
+				`Internal error occurred: failed calling webhook "webhook.cert-manager.io": failed to call webhook: ` +
+				`Post "https://cert-manager-webhook.cert-manager.svc:443/validate?timeout=30s": ` +
+				`service "cert-manager-webhook" not found` + "\n" +
+				"[SomeResourcesRemain] Some resources are remaining: certificates.cert-manager.io has 1 resource instances\n" +
+				"[SomeFinalizersRemain] Some content in the namespace has finalizers remaining: " +
+				"finalizer.acme.cert-manager.io in 1 resource instances"),
+		},
+		{
+			"Namespace with content removed",
+			"states/kubernetes/deletion/namespaceContentRemoved.json",
+			true,
+			logging.Message{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := namespaceContentDeleted(test.LoadFixture[corev1.Namespace](t, tt.testStatePath))
+			assert.Equal(t, tt.want, result.Ok)
+			assert.Equal(t, tt.wantMessage, result.Message)
+		})
+	}
+}
+
+func Test_namespaceFinalizersRemoved(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+		wantMessage   logging.Message
+	}{
+		{
+			"Namespace with the kubernetes finalizer",
+			"states/kubernetes/deletion/namespaceContentRemoved.json",
+			false,
+			logging.StatusMessage(`Deletion is blocked by finalizer "kubernetes"`),
+		},
+		{
+			"Namespace without finalizers",
+			"states/kubernetes/deletion/namespaceFinalized.json",
+			true,
+			logging.Message{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := namespaceFinalizersRemoved(test.LoadFixture[corev1.Namespace](t, tt.testStatePath))
+			assert.Equal(t, tt.want, result.Ok)
+			assert.Equal(t, tt.wantMessage, result.Message)
+		})
+	}
+}
+
+func Test_namespaceFinalizersRemoved_DoesNotModifyState(t *testing.T) {
+	finalizers := make([]string, 1, 2)
+	finalizers[0] = "example.com/cleanup"
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "foo", Finalizers: finalizers},
+		Spec:       corev1.NamespaceSpec{Finalizers: []corev1.FinalizerName{corev1.FinalizerKubernetes}},
+	}
+
+	result := namespaceFinalizersRemoved(ns)
+	assert.Equal(t, `Deletion is blocked by finalizers "example.com/cleanup", "kubernetes"`, result.Message.S)
+	assert.Equal(t, []string{"example.com/cleanup"}, ns.Finalizers)
+	assert.Empty(t, finalizers[:2][1])
+}
+
+func Test_blockedByFinalizers(t *testing.T) {
+	assert.Equal(t, `Deletion is blocked by finalizers "kubernetes.io/pvc-protection", "example.com/cleanup"`,
+		blockedByFinalizers([]string{"kubernetes.io/pvc-protection", "example.com/cleanup"}))
+}
+
+//
+// Test Checkers
+//
+
+func Test_Deletion_Checker(t *testing.T) {
+	tests := []struct {
+		name          string
+		workflowPath  string
+		deleted       bool
+		expectReady   bool
+		expectMessage string
+	}{
+		{
+			name:         "Object deleted",
+			workflowPath: workflowPath("bucket"),
+			deleted:      true,
+			expectReady:  true,
+			expectMessage: `["done"] Waiting for Bucket "artifacts" to be marked for deletion
+["done"] Waiting for Bucket "artifacts" finalizers to be removed
+`,
+		},
+		{
+			name:          "Object not deleted",
+			workflowPath:  workflowPath("bucket"),
+			expectReady:   false,
+			expectMessage: `["done"] Waiting for Bucket "artifacts" finalizers to be removed`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			states := test.LoadWorkflowFixtures[unstructured.Unstructured](t, tt.workflowPath)
+			if tt.deleted {
+				states = append(states, nil)
+			}
+			result := checker.AwaitDeletion(context.Background(), NewDeletionChecker(), test.Stream(states))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			assert.Contains(t, result.Results.String(), tt.expectMessage)
+		})
+	}
+}
+
+func Test_Deletion_Checker_Progress(t *testing.T) {
+	deletionChecker := NewDeletionChecker()
+
+	var statuses []string
+	for _, obj := range test.LoadWorkflowFixtures[unstructured.Unstructured](t, workflowPath("bucket")) {
+		_, result := deletionChecker.ReadyStatus(obj)
+		statuses = append(statuses, result.String())
+	}
+
+	assert.Equal(t, []string{
+		`["pending"] Waiting for Bucket "artifacts" to be marked for deletion`,
+		`["pending"] Waiting for Bucket "artifacts" finalizers to be removed -- Deletion is blocked by finalizer "finalizer.managedresource.crossplane.io"`,
+		`["pending"] Waiting for Bucket "artifacts" finalizers to be removed -- Deletion is blocked by finalizer "finalizer.managedresource.crossplane.io"`,
+		`["done"] Waiting for Bucket "artifacts" finalizers to be removed`,
+	}, statuses)
+}
+
+func Test_Namespace_Deletion_Checker_Progress(t *testing.T) {
+	namespaceChecker := NewNamespaceDeletionChecker()
+
+	var statuses []string
+	for _, ns := range test.LoadWorkflowFixtures[corev1.Namespace](t, workflowPath("namespace")) {
+		_, result := namespaceChecker.ReadyStatus(ns)
+		statuses = append(statuses, result.Description)
+	}
+
+	assert.Equal(t, []string{
+		`Waiting for Namespace "staging" to be marked for deletion`,
+		`Waiting for Namespace "staging" content to be deleted`,
+		`Waiting for Namespace "staging" content to be deleted`,
+		`Waiting for Namespace "staging" finalizers to be removed`,
+		`Waiting for Namespace "staging" finalizers to be removed`,
+	}, statuses)
+}
+
+func Test_Untyped_Namespace_Deletion_Checker(t *testing.T) {
+	var states []interface{}
+	for _, obj := range test.LoadWorkflowFixtures[unstructured.Unstructured](t, workflowPath("namespace")) {
+		states = append(states, obj)
+	}
+	states = append(states, nil)
+
+	result := checker.AwaitDeletion(context.Background(), NewUntypedNamespaceDeletionChecker(), test.Stream(states))
+	assert.True(t, result.Ready())
+	assert.Equal(t, checker.StatusReady, result.Results.Status())
+}
+
+func Test_Untyped_Namespace_Deletion_Checker_Typed_Nil(t *testing.T) {
+	tests := []struct {
+		name    string
+		deleted interface{}
+	}{
+		{"Namespace", (*corev1.Namespace)(nil)},
+		{"Unstructured", (*unstructured.Unstructured)(nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			namespaceChecker := NewUntypedNamespaceDeletionChecker()
+			assert.NotPanics(t, func() {
+				ready, result := namespaceChecker.ReadyStatus(tt.deleted)
+				assert.False(t, ready)
+				assert.Contains(t, result.Message.S, "unexpected nil state")
+			})
+
+			var states []interface{}
+			for _, obj := range test.LoadWorkflowFixtures[unstructured.Unstructured](t, workflowPath("namespace")) {
+				states = append(states, obj)
+			}
+			states = append(states, tt.deleted)
+
+			result := checker.AwaitDeletion(context.Background(), namespaceChecker, test.Stream(states))
+			assert.True(t, result.Ready())
+		})
+	}
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/deletion/%s.json", name)
+}
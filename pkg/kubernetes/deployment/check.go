@@ -0,0 +1,167 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func NewDeploymentChecker() *checker.StateChecker[*appsv1.Deployment] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*appsv1.Deployment]{
+		Conditions: []checker.Condition[*appsv1.Deployment]{
+			deploymentObserved,
+			deploymentProgressing,
+			deploymentReplicasUpdated,
+			deploymentOldReplicasScaledDown,
+			deploymentReplicasAvailable,
+		},
+	})
+}
+
+// NewUntypedDeploymentChecker returns a deployment checker for callers that pass states as interface{}. States may
+// be either *appsv1.Deployment or *unstructured.Unstructured.
+func NewUntypedDeploymentChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewDeploymentChecker(), kubernetes.FromUnstructured[appsv1.Deployment])
+}
+
+//
+// Conditions
+//
+
+func deploymentObserved(deployment *appsv1.Deployment) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Deployment %q to be observed by the controller", kubernetes.FullyQualifiedName(deployment))}
+
+	if deployment.Status.ObservedGeneration >= deployment.Generation {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func deploymentProgressing(deployment *appsv1.Deployment) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Deployment %q to make progress", kubernetes.FullyQualifiedName(deployment))}
+
+	if deployment.Spec.Paused {
+		// The controller neither rolls out a paused Deployment nor enforces its progress deadline, so an incomplete
+		// rollout would never make progress.
+		if rolledOut(deployment) {
+			result.Ok = true
+			result.Message = logging.StatusMessage("Deployment is paused")
+		} else {
+			result.Failed = true
+			result.Message = logging.ErrorMessage(
+				"[DeploymentPaused] Deployment is paused before its rollout completed; resume it with `kubectl rollout resume`")
+		}
+		return result
+	}
+
+	condition, found := filterConditions(deployment.Status.Conditions, appsv1.DeploymentProgressing)
+	if !found {
+		return result
+	}
+
+	switch {
+	case condition.Reason == "ProgressDeadlineExceeded":
+		// The controller stops retrying once the progress deadline has passed.
+		result.Failed = true
+		result.Message = logging.ErrorMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+	case condition.Status == corev1.ConditionTrue:
+		result.Ok = true
+	case len(condition.Message) > 0:
+		result.Message = logging.StatusMessage(condition.Message)
+	}
+
+	return result
+}
+
+func deploymentReplicasUpdated(deployment *appsv1.Deployment) checker.Result {
+	desired := desiredReplicas(deployment)
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Deployment %q to update replicas (Updated: %d/%d)",
+		kubernetes.FullyQualifiedName(deployment), deployment.Status.UpdatedReplicas, desired)}
+
+	if deployment.Status.UpdatedReplicas >= desired {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func deploymentOldReplicasScaledDown(deployment *appsv1.Deployment) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for old replicas of Deployment %q to be scaled down", kubernetes.FullyQualifiedName(deployment))}
+
+	old := deployment.Status.Replicas - deployment.Status.UpdatedReplicas
+	if old <= 0 {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(fmt.Sprintf("%d old replicas are pending termination", old))
+	return result
+}
+
+func deploymentReplicasAvailable(deployment *appsv1.Deployment) checker.Result {
+	desired := desiredReplicas(deployment)
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Deployment %q replicas to become available (Available: %d/%d)",
+		kubernetes.FullyQualifiedName(deployment), deployment.Status.AvailableReplicas, desired)}
+
+	if deployment.Status.AvailableReplicas >= desired {
+		result.Ok = true
+	}
+
+	return result
+}
+
+//
+// Helpers
+//
+
+func desiredReplicas(deployment *appsv1.Deployment) int32 {
+	if deployment.Spec.Replicas == nil {
+		return 1
+	}
+	return *deployment.Spec.Replicas
+}
+
+// rolledOut returns whether every desired replica of the Deployment has been updated and is available, and no old
+// replicas remain.
+func rolledOut(deployment *appsv1.Deployment) bool {
+	desired := desiredReplicas(deployment)
+	return deployment.Status.UpdatedReplicas >= desired &&
+		deployment.Status.AvailableReplicas >= desired &&
+		deployment.Status.Replicas <= deployment.Status.UpdatedReplicas
+}
+
+func filterConditions(
+	conditions []appsv1.DeploymentCondition, desired appsv1.DeploymentConditionType,
+) (*appsv1.DeploymentCondition, bool) {
+	for _, condition := range conditions {
+		if condition.Type == desired {
+			return &condition, true
+		}
+	}
+
+	return nil, false
+}
@@ -0,0 +1,246 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package deployment
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_deploymentConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     checker.Condition[*appsv1.Deployment]
+		testStatePath string
+		want          checker.Status
+	}{
+		{
+			"Deployment observed",
+			deploymentObserved,
+			"states/kubernetes/deployment/ready.json",
+			checker.StatusReady,
+		},
+		{
+			"Deployment unobserved",
+			deploymentObserved,
+			"states/kubernetes/deployment/unobserved.json",
+			checker.StatusPending,
+		},
+		{
+			"Deployment progressing",
+			deploymentProgressing,
+			"states/kubernetes/deployment/replicasUpdating.json",
+			checker.StatusReady,
+		},
+		{
+			"Deployment progress deadline exceeded",
+			deploymentProgressing,
+			"states/kubernetes/deployment/progressDeadlineExceeded.json",
+			checker.StatusFailed,
+		},
+		{
+			"Deployment paused during a rollout",
+			deploymentProgressing,
+			"states/kubernetes/deployment/paused.json",
+			checker.StatusFailed,
+		},
+		{
+			"Deployment replicas updated",
+			deploymentReplicasUpdated,
+			"states/kubernetes/deployment/oldReplicasPending.json",
+			checker.StatusReady,
+		},
+		{
+			"Deployment replicas updating",
+			deploymentReplicasUpdated,
+			"states/kubernetes/deployment/replicasUpdating.json",
+			checker.StatusPending,
+		},
+		{
+			"Deployment old replicas scaled down",
+			deploymentOldReplicasScaledDown,
+			"states/kubernetes/deployment/ready.json",
+			checker.StatusReady,
+		},
+		{
+			"Deployment old replicas pending termination",
+			deploymentOldReplicasScaledDown,
+			"states/kubernetes/deployment/oldReplicasPending.json",
+			checker.StatusPending,
+		},
+		{
+			"Deployment replicas available",
+			deploymentReplicasAvailable,
+			"states/kubernetes/deployment/ready.json",
+			checker.StatusReady,
+		},
+		{
+			"Deployment replicas unavailable",
+			deploymentReplicasAvailable,
+			"states/kubernetes/deployment/replicasUnavailable.json",
+			checker.StatusPending,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deployment := test.LoadFixture[appsv1.Deployment](t, tt.testStatePath)
+			if got := tt.condition(deployment); got.Status() != tt.want {
+				t.Errorf("Status() = %v, want %v", got.Status(), tt.want)
+			}
+		})
+	}
+}
+
+func Test_deploymentProgressing_Paused(t *testing.T) {
+	paused := test.LoadFixture[appsv1.Deployment](t, "states/kubernetes/deployment/paused.json")
+	got := deploymentProgressing(paused)
+	assert.True(t, got.Failed)
+	assert.Equal(t, "[DeploymentPaused] Deployment is paused before its rollout completed; "+
+		"resume it with `kubectl rollout resume`", got.Message.S)
+
+	rolledOut := test.LoadFixture[appsv1.Deployment](t, "states/kubernetes/deployment/ready.json")
+	rolledOut.Spec.Paused = true
+	got = deploymentProgressing(rolledOut)
+	assert.True(t, got.Ok)
+	assert.Equal(t, "Deployment is paused", got.Message.S)
+}
+
+func Test_deploymentReplicasAvailable_ScaledUp(t *testing.T) {
+	deployment := test.LoadFixture[appsv1.Deployment](t, "states/kubernetes/deployment/ready.json")
+	replicas := *deployment.Spec.Replicas + 1
+	deployment.Spec.Replicas = &replicas
+
+	got := deploymentReplicasAvailable(deployment)
+	assert.False(t, got.Ok)
+	assert.Equal(t, `Waiting for Deployment "foo" replicas to become available (Available: 2/3)`, got.Description)
+}
+
+//
+// Test Deployment State Checker using recorded events.
+//
+
+func Test_Deployment_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		added                    = "added"
+		created                  = "created"
+		createSuccess            = "createSuccess"
+		progressDeadlineExceeded = "progressDeadlineExceeded"
+		rollingUpdate            = "rollingUpdate"
+	)
+
+	tests := []struct {
+		name          string
+		workflowPaths []string
+		expectReady   bool
+		expectFailed  bool
+		expectMessage string
+	}{
+		{
+			name:          "Deployment added but not observed",
+			workflowPaths: []string{workflow(added)},
+			expectReady:   false,
+			expectMessage: `["pending"] Waiting for Deployment "foo" to be observed by the controller
+`,
+		},
+		{
+			name:          "Deployment created but replicas unavailable",
+			workflowPaths: []string{workflow(created)},
+			expectReady:   false,
+			expectMessage: `["pending"] Waiting for Deployment "foo" replicas to become available (Available: 0/2)
+`,
+		},
+		{
+			name:          "Deployment create success",
+			workflowPaths: []string{workflow(createSuccess)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for Deployment "foo" to be observed by the controller
+["done"] Waiting for Deployment "foo" to make progress
+["done"] Waiting for Deployment "foo" to update replicas (Updated: 2/2)
+["done"] Waiting for old replicas of Deployment "foo" to be scaled down
+["done"] Waiting for Deployment "foo" replicas to become available (Available: 2/2)
+`,
+		},
+		{
+			name:          "Deployment progress deadline exceeded",
+			workflowPaths: []string{workflow(progressDeadlineExceeded)},
+			expectReady:   false,
+			expectFailed:  true,
+			expectMessage: `["failed"] Waiting for Deployment "foo" to make progress -- [ProgressDeadlineExceeded] ReplicaSet "foo-6d4cf56db6" has timed out progressing.
+`,
+		},
+		{
+			name:          "Deployment paused during a rollout",
+			workflowPaths: []string{"states/kubernetes/deployment/paused.json"},
+			expectReady:   false,
+			expectFailed:  true,
+			expectMessage: `["failed"] Waiting for Deployment "foo" to make progress -- [DeploymentPaused] Deployment is paused before its rollout completed; resume it with ` + "`kubectl rollout resume`" + `
+`,
+		},
+		{
+			name:          "Deployment rolling update success",
+			workflowPaths: []string{workflow(rollingUpdate)},
+			expectReady:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deploymentStates := test.LoadWorkflowFixtures[appsv1.Deployment](t, tt.workflowPaths...)
+			result := checker.Await(context.Background(), NewDeploymentChecker(), test.Stream(deploymentStates))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			assert.Equal(t, tt.expectFailed, result.Results.Status() == checker.StatusFailed)
+			if tt.expectMessage != "" {
+				assert.Contains(t, result.Results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_Deployment_Checker_RollingUpdate(t *testing.T) {
+	deploymentChecker := NewDeploymentChecker()
+
+	var statuses []string
+	for _, deployment := range test.LoadWorkflowFixtures[appsv1.Deployment](t, workflowPath("rollingUpdate")) {
+		_, result := deploymentChecker.ReadyStatus(deployment)
+		statuses = append(statuses, result.String())
+	}
+
+	assert.Equal(t, []string{
+		`["pending"] Waiting for Deployment "foo" to be observed by the controller`,
+		`["pending"] Waiting for Deployment "foo" to update replicas (Updated: 1/2)`,
+		`["pending"] Waiting for old replicas of Deployment "foo" to be scaled down -- 1 old replicas are pending termination`,
+		`["done"] Waiting for Deployment "foo" replicas to become available (Available: 2/2)`,
+	}, statuses)
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/deployment/%s.json", name)
+}
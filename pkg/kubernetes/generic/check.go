@@ -0,0 +1,160 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ExpectedCondition is a status condition that must have the given Status for an object to be ready.
+type ExpectedCondition struct {
+	Type   string                 // The condition type, e.g., "Ready".
+	Status metav1.ConditionStatus // The required status. A missing condition satisfies an expected "False" status.
+	// If true, the condition having the opposite status is reported as a permanent failure, e.g., for "Stalled".
+	Terminal bool
+}
+
+// ConditionsCheckerArgs configures the conditions checked by NewConditionsChecker.
+type ConditionsCheckerArgs struct {
+	// Conditions that must have the expected status. Defaults to a "Ready" condition with status "True".
+	Conditions []ExpectedCondition
+}
+
+// NewConditionsChecker returns a checker for objects that follow the `status.conditions` convention, such as most
+// custom resources. The object must have observed its latest generation, and each expected condition must have the
+// expected status.
+func NewConditionsChecker(args *ConditionsCheckerArgs) *checker.StateChecker[*unstructured.Unstructured] {
+	expected := []ExpectedCondition{{Type: "Ready", Status: metav1.ConditionTrue}}
+	if args != nil && len(args.Conditions) > 0 {
+		expected = args.Conditions
+	}
+
+	conditions := []checker.Condition[*unstructured.Unstructured]{generationObserved}
+	for _, e := range expected {
+		conditions = append(conditions, func(obj *unstructured.Unstructured) checker.Result {
+			return conditionStatus(obj, e)
+		})
+	}
+
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*unstructured.Unstructured]{
+		Conditions: conditions,
+	})
+}
+
+//
+// Conditions
+//
+
+func generationObserved(obj *unstructured.Unstructured) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for %s %q to be observed by the controller", obj.GetKind(), kubernetes.FullyQualifiedName(obj))}
+
+	observed, found, err := unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	if err != nil {
+		result.Message = logging.WarningMessage(fmt.Sprintf("Invalid status.observedGeneration: %v", err))
+		return result
+	}
+
+	// Objects that don't report an observed generation can't be checked.
+	if !found || observed >= obj.GetGeneration() {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func conditionStatus(obj *unstructured.Unstructured, expected ExpectedCondition) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for %s %q condition %q to be %q",
+		obj.GetKind(), kubernetes.FullyQualifiedName(obj), expected.Type, expected.Status)}
+
+	conditions, err := statusConditions(obj)
+	if err != nil {
+		result.Message = logging.WarningMessage(fmt.Sprintf("Invalid status.conditions: %v", err))
+		return result
+	}
+
+	condition := findCondition(conditions, expected.Type)
+	if condition == nil {
+		result.Ok = expected.Status == metav1.ConditionFalse
+		return result
+	}
+
+	// A condition that was computed for an older generation no longer reflects the object's state.
+	if condition.ObservedGeneration != 0 && condition.ObservedGeneration < obj.GetGeneration() {
+		result.Message = logging.StatusMessage(fmt.Sprintf(
+			"Condition %q has not been updated for generation %d", expected.Type, obj.GetGeneration()))
+		return result
+	}
+
+	if condition.Status == expected.Status {
+		result.Ok = true
+		return result
+	}
+
+	msg := kubernetes.ConditionMessage(condition.Reason, condition.Message)
+	if expected.Terminal && condition.Status != metav1.ConditionUnknown {
+		if len(msg) == 0 {
+			msg = fmt.Sprintf("Condition %q is %q", condition.Type, condition.Status)
+		}
+		result.Failed = true
+		result.Message = logging.ErrorMessage(msg)
+		return result
+	}
+
+	if len(msg) > 0 {
+		if condition.Status == metav1.ConditionUnknown {
+			result.Message = logging.StatusMessage(msg)
+		} else {
+			result.Message = logging.WarningMessage(msg)
+		}
+	}
+	return result
+}
+
+//
+// Helpers
+//
+
+// statusConditions decodes `status.conditions`, returning nil if the object has no conditions.
+func statusConditions(obj *unstructured.Unstructured) ([]metav1.Condition, error) {
+	raw, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil, err
+	}
+
+	var status struct {
+		Conditions []metav1.Condition `json:"conditions"`
+	}
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(map[string]interface{}{"conditions": raw}, &status)
+	return status.Conditions, err
+}
+
+func findCondition(conditions []metav1.Condition, conditionType string) *metav1.Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+
+	return nil
+}
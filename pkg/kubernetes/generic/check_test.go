@@ -0,0 +1,208 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package generic
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+var (
+	ready       = ExpectedCondition{Type: "Ready", Status: metav1.ConditionTrue}
+	synced      = ExpectedCondition{Type: "Synced", Status: metav1.ConditionTrue}
+	notDegraded = ExpectedCondition{Type: "Degraded", Status: metav1.ConditionFalse}
+)
+
+//
+// Test Conditions
+//
+
+func Test_generationObserved(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{
+			"Object without observedGeneration",
+			"states/kubernetes/generic/certificateIssuing.json",
+			true,
+		},
+		{
+			"Object with stale observedGeneration",
+			"states/kubernetes/generic/databaseUnobserved.json",
+			false,
+		},
+		{
+			"Object with current observedGeneration",
+			"states/kubernetes/generic/databaseDegraded.json",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := test.LoadFixture[unstructured.Unstructured](t, tt.testStatePath)
+			if got := generationObserved(obj); got.Ok != tt.want {
+				t.Errorf("generationObserved() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_conditionStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		expected      ExpectedCondition
+		want          bool
+		wantMessage   logging.Message
+	}{
+		{
+			name:          "Ready condition false",
+			testStatePath: "states/kubernetes/generic/certificateIssuing.json",
+			expected:      ready,
+			want:          false,
+			wantMessage:   logging.WarningMessage("[DoesNotExist] Issuing certificate as Secret does not exist"),
+		},
+		{
+			name:          "Ready condition true",
+			testStatePath: "states/kubernetes/generic/certificateReady.json",
+			expected:      ready,
+			want:          true,
+		},
+		{
+			name:          "Condition missing",
+			testStatePath: "states/kubernetes/generic/certificateReady.json",
+			expected:      synced,
+			want:          false,
+		},
+		{
+			name:          "Negative polarity condition missing",
+			testStatePath: "states/kubernetes/generic/certificateReady.json",
+			expected:      notDegraded,
+			want:          true,
+		},
+		{
+			name:          "Negative polarity condition true",
+			testStatePath: "states/kubernetes/generic/databaseDegraded.json",
+			expected:      notDegraded,
+			want:          false,
+			wantMessage:   logging.WarningMessage("[VolumeFull] Disk usage is above 95%"),
+		},
+		{
+			name:          "Condition for an older generation",
+			testStatePath: "states/kubernetes/generic/bucketStale.json",
+			expected:      ready,
+			want:          false,
+			wantMessage:   logging.StatusMessage(`Condition "Ready" has not been updated for generation 2`),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			obj := test.LoadFixture[unstructured.Unstructured](t, tt.testStatePath)
+			got := conditionStatus(obj, tt.expected)
+			assert.Equal(t, tt.want, got.Ok)
+			assert.Equal(t, tt.wantMessage, got.Message)
+		})
+	}
+}
+
+//
+// Test Conditions State Checker using recorded events.
+//
+
+func Test_Conditions_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		bucket      = "bucket"
+		certificate = "certificate"
+		database    = "database"
+	)
+
+	tests := []struct {
+		name          string
+		args          *ConditionsCheckerArgs
+		workflowPaths []string
+		expectReady   bool
+		expectMessage string
+	}{
+		{
+			name:          "Certificate issued",
+			workflowPaths: []string{workflow(certificate)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for Certificate "shop-tls" to be observed by the controller
+["done"] Waiting for Certificate "shop-tls" condition "Ready" to be "True"
+`,
+		},
+		{
+			name:          "Crossplane resource synced and ready",
+			args:          &ConditionsCheckerArgs{Conditions: []ExpectedCondition{synced, ready}},
+			workflowPaths: []string{workflow(bucket)},
+			expectReady:   true,
+		},
+		{
+			name:          "Resource ready and not degraded",
+			args:          &ConditionsCheckerArgs{Conditions: []ExpectedCondition{ready, notDegraded}},
+			workflowPaths: []string{workflow(database)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for Database "orders" condition "Degraded" to be "False"
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			states := test.LoadWorkflowFixtures[unstructured.Unstructured](t, tt.workflowPaths...)
+			result := checker.Await(context.Background(), NewConditionsChecker(tt.args), test.Stream(states))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			if tt.expectMessage != "" {
+				assert.Contains(t, result.Results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_Conditions_Checker_Progress(t *testing.T) {
+	conditionsChecker := NewConditionsChecker(&ConditionsCheckerArgs{Conditions: []ExpectedCondition{synced, ready}})
+
+	var statuses []string
+	for _, obj := range test.LoadWorkflowFixtures[unstructured.Unstructured](t, workflowPath("bucket")) {
+		_, result := conditionsChecker.ReadyStatus(obj)
+		statuses = append(statuses, result.String())
+	}
+
+	assert.Equal(t, []string{
+		`["pending"] Waiting for Bucket "artifacts" condition "Synced" to be "True" -- Condition "Synced" has not been updated for generation 2`,
+		`["pending"] Waiting for Bucket "artifacts" condition "Synced" to be "True" -- [ReconcileError] update failed: cannot update Bucket: operation error S3: PutBucketTagging, https response error StatusCode: 403, api error AccessDenied: Access Denied`,
+		`["done"] Waiting for Bucket "artifacts" condition "Ready" to be "True"`,
+	}, statuses)
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/generic/%s.json", name)
+}
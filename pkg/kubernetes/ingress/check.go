@@ -0,0 +1,135 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/service"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ServiceLookup finds the backend Services referenced by an Ingress.
+type ServiceLookup interface {
+	// LookupService returns the named Service along with its EndpointSlices, or false if the Service does not exist.
+	LookupService(namespace, name string) (*service.State, bool)
+}
+
+// NewIngressChecker returns a checker that waits for an Ingress to be assigned a load balancer ingress.
+func NewIngressChecker() *checker.StateChecker[*networkingv1.Ingress] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*networkingv1.Ingress]{
+		Conditions: []checker.Condition[*networkingv1.Ingress]{ingressLoadBalancerIngress},
+	})
+}
+
+// NewUntypedIngressChecker returns an ingress checker for callers that pass states as interface{}. States may be
+// either *networkingv1.Ingress or *unstructured.Unstructured.
+func NewUntypedIngressChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewIngressChecker(), kubernetes.FromUnstructured[networkingv1.Ingress])
+}
+
+// NewIngressWithBackendsChecker returns a checker that additionally verifies that every backend Service referenced
+// by the Ingress exists and has ready endpoints, using the provided ServiceLookup.
+func NewIngressWithBackendsChecker(lookup ServiceLookup) *checker.StateChecker[*networkingv1.Ingress] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*networkingv1.Ingress]{
+		Conditions: []checker.Condition[*networkingv1.Ingress]{
+			ingressLoadBalancerIngress,
+			func(ingress *networkingv1.Ingress) checker.Result { return ingressBackendsReady(ingress, lookup) },
+		},
+	})
+}
+
+//
+// Conditions
+//
+
+func ingressLoadBalancerIngress(ingress *networkingv1.Ingress) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Ingress %q to be assigned a load balancer ingress", kubernetes.FullyQualifiedName(ingress))}
+
+	if len(ingress.Status.LoadBalancer.Ingress) > 0 {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func ingressBackendsReady(ingress *networkingv1.Ingress, lookup ServiceLookup) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Ingress %q backend Services to be ready", kubernetes.FullyQualifiedName(ingress))}
+
+	var err error
+	for _, name := range backendServiceNames(ingress) {
+		err = errors.Join(err, backendServiceError(lookup, ingress.Namespace, name))
+	}
+
+	if err != nil {
+		result.Message = logging.WarningMessage(fmt.Sprintf(
+			"[Ingress %s]: %s", kubernetes.FullyQualifiedName(ingress), err))
+		return result
+	}
+
+	result.Ok = true
+	return result
+}
+
+//
+// Helpers
+//
+
+func backendServiceError(lookup ServiceLookup, namespace, name string) error {
+	fqn := kubernetes.FullyQualifiedName(&metav1.ObjectMeta{Namespace: namespace, Name: name})
+
+	state, found := lookup.LookupService(namespace, name)
+	if !found || state == nil || state.Service == nil {
+		return fmt.Errorf("Backend Service %q does not exist", fqn)
+	}
+	if !service.EndpointsReady(state) {
+		return fmt.Errorf("Backend Service %q does not have any ready endpoints", fqn)
+	}
+
+	return nil
+}
+
+// backendServiceNames returns the names of the Services referenced by the Ingress's default backend and rules, in the
+// order they first appear.
+func backendServiceNames(ingress *networkingv1.Ingress) []string {
+	var names []string
+	seen := map[string]bool{}
+	add := func(backend *networkingv1.IngressBackend) {
+		if backend == nil || backend.Service == nil || seen[backend.Service.Name] {
+			return
+		}
+		seen[backend.Service.Name] = true
+		names = append(names, backend.Service.Name)
+	}
+
+	add(ingress.Spec.DefaultBackend)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.HTTP == nil {
+			continue
+		}
+		for _, path := range rule.HTTP.Paths {
+			add(&path.Backend)
+		}
+	}
+
+	return names
+}
@@ -0,0 +1,155 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/service"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+)
+
+// fakeLookup is a ServiceLookup backed by a map of Service names to states.
+type fakeLookup map[string]*service.State
+
+func (l fakeLookup) LookupService(_, name string) (*service.State, bool) {
+	state, ok := l[name]
+	return state, ok
+}
+
+//
+// Test Conditions
+//
+
+func Test_ingressLoadBalancerIngress(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{
+			"Ingress pending",
+			"states/kubernetes/ingress/loadBalancerPending.json",
+			false,
+		},
+		{
+			"Ingress ready",
+			"states/kubernetes/ingress/loadBalancerReady.json",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := test.LoadFixture[networkingv1.Ingress](t, tt.testStatePath)
+			if got := ingressLoadBalancerIngress(ingress); got.Ok != tt.want {
+				t.Errorf("ingressLoadBalancerIngress() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_ingressBackendsReady(t *testing.T) {
+	ready := &service.State{
+		Service: test.LoadFixture[corev1.Service](t, "states/kubernetes/service/clusterIP.json"),
+		EndpointSlices: []*discoveryv1.EndpointSlice{
+			test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/ready.json"),
+		},
+	}
+	unready := &service.State{
+		Service: test.LoadFixture[corev1.Service](t, "states/kubernetes/service/clusterIP.json"),
+		EndpointSlices: []*discoveryv1.EndpointSlice{
+			test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/unready.json"),
+		},
+	}
+	external := &service.State{Service: test.LoadFixture[corev1.Service](t, "states/kubernetes/service/externalName.json")}
+
+	tests := []struct {
+		name          string
+		lookup        fakeLookup
+		want          bool
+		expectMessage string
+	}{
+		{
+			name:   "All backends ready",
+			lookup: fakeLookup{"web": ready, "api": ready, "fallback": external},
+			want:   true,
+		},
+		{
+			name:   "Missing backends",
+			lookup: fakeLookup{"web": ready},
+			want:   false,
+			expectMessage: `[Ingress web]: Backend Service "fallback" does not exist
+Backend Service "api" does not exist`,
+		},
+		{
+			name:          "Backend without ready endpoints",
+			lookup:        fakeLookup{"web": unready, "api": ready, "fallback": external},
+			want:          false,
+			expectMessage: `[Ingress web]: Backend Service "web" does not have any ready endpoints`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ingress := test.LoadFixture[networkingv1.Ingress](t, "states/kubernetes/ingress/loadBalancerReady.json")
+			got := ingressBackendsReady(ingress, tt.lookup)
+			assert.Equal(t, tt.want, got.Ok)
+			assert.Equal(t, tt.expectMessage, got.Message.S)
+			if tt.expectMessage != "" {
+				assert.Equal(t, diag.Warning, got.Message.Severity)
+			}
+		})
+	}
+}
+
+//
+// Test Ingress State Checker using recorded events.
+//
+
+func Test_Ingress_Checker(t *testing.T) {
+	ingresses := test.LoadWorkflowFixtures[networkingv1.Ingress](t, workflowPath("loadBalancer"))
+
+	result := checker.Await(context.Background(), NewIngressChecker(), test.Stream(ingresses))
+	assert.True(t, result.Ready())
+
+	lookup := fakeLookup{"web": {Service: test.LoadFixture[corev1.Service](t, "states/kubernetes/service/headless.json")}}
+	result = checker.Await(context.Background(), NewIngressWithBackendsChecker(lookup), test.Stream(ingresses))
+	assert.False(t, result.Ready())
+	assert.Equal(t, []string{`[Ingress web]: Backend Service "fallback" does not exist
+Backend Service "api" does not exist`}, messageStrings(result.Results))
+}
+
+//
+// Helpers
+//
+
+func messageStrings(results checker.Results) []string {
+	var messages []string
+	for _, message := range results.Messages().Warnings() {
+		messages = append(messages, message.S)
+	}
+	return messages
+}
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/ingress/%s.json", name)
+}
@@ -16,6 +16,10 @@ package job
 
 import (
 	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
 
 	"github.com/pulumi/cloud-ready-checks/pkg/checker"
 	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
@@ -24,47 +28,94 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-func NewJobChecker() *checker.StateChecker {
-	return checker.NewStateChecker(&checker.StateCheckerArgs{
-		Conditions: []checker.Condition{jobStarted, jobComplete},
+func NewJobChecker() *checker.StateChecker[*batchv1.Job] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*batchv1.Job]{
+		Conditions: []checker.Condition[*batchv1.Job]{jobNotSuspended, jobStarted, jobComplete},
 	})
 }
 
+// NewUntypedJobChecker returns a job checker for callers that pass states as interface{}. States may be either
+// *batchv1.Job or *unstructured.Unstructured.
+func NewUntypedJobChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewJobChecker(), kubernetes.FromUnstructured[batchv1.Job])
+}
+
 //
 // Conditions
 //
 
-func jobStarted(obj interface{}) checker.Result {
-	job := toJob(obj)
+func jobNotSuspended(job *batchv1.Job) checker.Result {
 	result := checker.Result{Description: fmt.Sprintf(
-		"Waiting for Job %q to start", kubernetes.FullyQualifiedName(job))}
+		"Waiting for Job %q to be resumed", kubernetes.FullyQualifiedName(job))}
 
-	if job.Status.StartTime != nil {
+	conditions := newJobConditions(job)
+	if condition, found := conditions[batchv1.JobComplete]; found && condition.Status == corev1.ConditionTrue {
 		result.Ok = true
+		return result
+	}
+	// A Job can fail while it is suspended, e.g., with DeadlineExceeded, in which case jobComplete reports the failure.
+	if len(collectJobConditionErrors(job, conditions)) > 0 {
+		result.Ok = true
+		return result
 	}
 
+	if condition, found := conditions[batchv1.JobSuspended]; found && condition.Status == corev1.ConditionTrue {
+		result.Message = logging.StatusMessage(kubernetes.ConditionMessage(condition.Reason, condition.Message))
+		return result
+	}
+	if job.Spec.Suspend != nil && *job.Spec.Suspend {
+		result.Message = logging.StatusMessage("Job is suspended")
+		return result
+	}
+
+	result.Ok = true
 	return result
 }
 
-func jobComplete(obj interface{}) checker.Result {
-	job := toJob(obj)
+func jobStarted(job *batchv1.Job) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Job %q to start", kubernetes.FullyQualifiedName(job))}
+
+	// A Job that failed before it started, e.g., while it was suspended, is reported as failed by jobComplete.
+	if job.Status.StartTime != nil || len(collectJobConditionErrors(job, newJobConditions(job))) > 0 {
+		result.Ok = true
+	}
+
+	return result
+}
 
+func jobComplete(job *batchv1.Job) checker.Result {
 	progressStr := fmt.Sprintf("(Active: %d | Succeeded: %d | Failed: %d)",
 		job.Status.Active, job.Status.Succeeded, job.Status.Failed)
 	result := checker.Result{Description: fmt.Sprintf(
 		"Waiting for Job %q to succeed %s", kubernetes.FullyQualifiedName(job), progressStr)}
 
-	conditions := jobConditions{}
-	for _, condition := range job.Status.Conditions {
-		conditions[condition.Type] = condition
-	}
-
-	if err := collectJobConditionErrors(conditions); len(err) > 0 {
+	conditions := newJobConditions(job)
+	if err := collectJobConditionErrors(job, conditions); len(err) > 0 {
+		// A failed Job is never retried, so it will not become ready.
+		result.Failed = true
 		result.Message = logging.ErrorMessage(err)
 		return result
 	}
 	if condition, found := conditions[batchv1.JobComplete]; found && condition.Status == corev1.ConditionTrue {
 		result.Ok = true
+		return result
+	}
+	// A Job whose successPolicy is met is complete once its remaining Pods are terminated.
+	if condition, found := conditions[batchv1.JobSuccessCriteriaMet]; found && condition.Status == corev1.ConditionTrue {
+		result.Ok = true
+		return result
+	}
+
+	if isIndexed(job) {
+		progress := indexedProgress(job)
+		if err := failedIndexesError(job); len(err) > 0 {
+			// The failed indexes are not retried, so the Job will fail once its other indexes are done.
+			result.Failed = true
+			result.Message = logging.ErrorMessage(fmt.Sprintf("%s\n%s", progress, err))
+			return result
+		}
+		result.Message = logging.StatusMessage(progress)
 	}
 
 	return result
@@ -74,19 +125,145 @@ func jobComplete(obj interface{}) checker.Result {
 // Helpers
 //
 
-func toJob(obj interface{}) *batchv1.Job {
-	return obj.(*batchv1.Job)
+type jobConditions map[batchv1.JobConditionType]batchv1.JobCondition
+
+func newJobConditions(job *batchv1.Job) jobConditions {
+	conditions := jobConditions{}
+	for _, condition := range job.Status.Conditions {
+		conditions[condition.Type] = condition
+	}
+
+	return conditions
 }
 
-type jobConditions map[batchv1.JobConditionType]batchv1.JobCondition
+// collectJobConditionErrors returns the reasons and messages of the Job's Failed and FailureTarget conditions. The
+// FailureTarget condition is added as soon as the Job controller decides that the Job failed, before its Pods are
+// terminated and the Failed condition is added.
+func collectJobConditionErrors(job *batchv1.Job, conditions jobConditions) string {
+	var errs []string
+	for _, conditionType := range []batchv1.JobConditionType{batchv1.JobFailed, batchv1.JobFailureTarget} {
+		condition, found := conditions[conditionType]
+		if !found || condition.Status != corev1.ConditionTrue {
+			continue
+		}
+
+		err := kubernetes.ConditionMessage(condition.Reason, condition.Message)
+		if rule := matchedPodFailurePolicyRule(job, condition); len(rule) > 0 {
+			err += "\n" + rule
+		}
+		if !slices.Contains(errs, err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return strings.Join(errs, "\n")
+}
+
+var podFailurePolicyRuleRegexp = regexp.MustCompile(`matching \w+ rule at index (\d+)`)
+
+// matchedPodFailurePolicyRule quotes the podFailurePolicy rule that failed the Job. The Job controller only reports the
+// index of the rule, e.g., "Container main for pod default/foo-x7k2p failed with exit code 42 matching FailJob rule
+// at index 1".
+func matchedPodFailurePolicyRule(job *batchv1.Job, condition batchv1.JobCondition) string {
+	if job.Spec.PodFailurePolicy == nil || condition.Reason != batchv1.JobReasonPodFailurePolicy {
+		return ""
+	}
+	match := podFailurePolicyRuleRegexp.FindStringSubmatch(condition.Message)
+	if match == nil {
+		return ""
+	}
+	index, err := strconv.Atoi(match[1])
+	if err != nil || index >= len(job.Spec.PodFailurePolicy.Rules) {
+		return ""
+	}
+
+	return fmt.Sprintf("Matched podFailurePolicy rule %d: %s",
+		index, formatPodFailurePolicyRule(job.Spec.PodFailurePolicy.Rules[index]))
+}
+
+func formatPodFailurePolicyRule(rule batchv1.PodFailurePolicyRule) string {
+	var requirements []string
+	if onExitCodes := rule.OnExitCodes; onExitCodes != nil {
+		requirement := fmt.Sprintf("exit code %s %v", onExitCodes.Operator, onExitCodes.Values)
+		if onExitCodes.ContainerName != nil {
+			requirement += fmt.Sprintf(" of container %q", *onExitCodes.ContainerName)
+		}
+		requirements = append(requirements, requirement)
+	}
+	for _, onPodCondition := range rule.OnPodConditions {
+		requirements = append(requirements,
+			fmt.Sprintf("Pod condition %s=%s", onPodCondition.Type, onPodCondition.Status))
+	}
+
+	return fmt.Sprintf("action %s on %s", rule.Action, strings.Join(requirements, " or "))
+}
 
-func collectJobConditionErrors(conditions jobConditions) string {
-	if condition, found := conditions[batchv1.JobFailed]; found && condition.Status == corev1.ConditionTrue {
-		switch condition.Reason {
-		case "BackoffLimitExceeded", "DeadlineExceeded":
-			return fmt.Sprintf("[%s] %s", condition.Reason, condition.Message)
+func isIndexed(job *batchv1.Job) bool {
+	return job.Spec.CompletionMode != nil && *job.Spec.CompletionMode == batchv1.IndexedCompletion
+}
+
+// indexedProgress describes the progress of an Indexed Job, e.g., "7/10 indexes complete, failed: 3,5".
+func indexedProgress(job *batchv1.Job) string {
+	completed, _ := countIndexes(job.Status.CompletedIndexes)
+	progress := fmt.Sprintf("%d indexes complete", completed)
+	if job.Spec.Completions != nil {
+		progress = fmt.Sprintf("%d/%d indexes complete", completed, *job.Spec.Completions)
+	}
+	if job.Status.FailedIndexes != nil && len(*job.Status.FailedIndexes) > 0 {
+		progress += fmt.Sprintf(", failed: %s", *job.Status.FailedIndexes)
+	}
+
+	return progress
+}
+
+// failedIndexesError returns an error if the indexes that failed after reaching their backoffLimitPerIndex prevent the
+// Job from succeeding: if there are more than maxFailedIndexes, or if there are any and no successPolicy could still
+// be met.
+func failedIndexesError(job *batchv1.Job) string {
+	if job.Status.FailedIndexes == nil {
+		return ""
+	}
+	failed, err := countIndexes(*job.Status.FailedIndexes)
+	if err != nil || failed == 0 {
+		return ""
+	}
+
+	if limit := job.Spec.MaxFailedIndexes; limit != nil && int32(failed) > *limit {
+		return fmt.Sprintf("%d failed indexes exceed maxFailedIndexes of %d", failed, *limit)
+	}
+	if job.Spec.SuccessPolicy == nil {
+		msg := fmt.Sprintf("Indexes %s failed", *job.Status.FailedIndexes)
+		if limit := job.Spec.BackoffLimitPerIndex; limit != nil {
+			msg += fmt.Sprintf(" after reaching backoffLimitPerIndex of %d", *limit)
 		}
+		return msg
 	}
 
 	return ""
 }
+
+// countIndexes returns the number of indexes in an interval list like "0-2,4,6-8".
+func countIndexes(indexes string) (int, error) {
+	count := 0
+	if len(indexes) == 0 {
+		return count, nil
+	}
+
+	for _, interval := range strings.Split(indexes, ",") {
+		first, last, isRange := strings.Cut(interval, "-")
+		if !isRange {
+			last = first
+		}
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			return 0, fmt.Errorf("invalid index interval %q: %w", interval, err)
+		}
+		end, err := strconv.Atoi(last)
+		if err != nil {
+			return 0, fmt.Errorf("invalid index interval %q: %w", interval, err)
+		}
+		count += end - start + 1
+	}
+
+	return count, nil
+}
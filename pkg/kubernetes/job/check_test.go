@@ -15,16 +15,22 @@
 package job
 
 import (
+	"flag"
 	"fmt"
 	"testing"
 
-	"github.com/pulumi/cloud-ready-checks/internal"
 	"github.com/pulumi/cloud-ready-checks/pkg/checker"
 	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test/builder"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 )
 
+// The -update flag rewrites the golden files of the replayed workflows, e.g., `go test ./pkg/kubernetes/job -update`.
+var _ = flag.Bool(test.UpdateGoldenFlag, false, "update the golden files of replayed workflows")
+
 func Test_jobStarted(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -44,7 +50,7 @@ func Test_jobStarted(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			job := loadJob(t, tt.testStatePath)
+			job := test.LoadFixture[batchv1.Job](t, tt.testStatePath)
 			if got := jobStarted(job); got.Ok != tt.want {
 				t.Errorf("jobStarted() = %v, want %v", got.Ok, tt.want)
 			}
@@ -86,7 +92,7 @@ func Test_jobComplete(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			job := loadJob(t, tt.testStatePath)
+			job := test.LoadFixture[batchv1.Job](t, tt.testStatePath)
 			if got := jobComplete(job); got.Ok != tt.want {
 				t.Errorf("jobStarted() = %v, want %v", got.Ok, tt.want)
 			}
@@ -94,6 +100,147 @@ func Test_jobComplete(t *testing.T) {
 	}
 }
 
+func Test_jobNotSuspended(t *testing.T) {
+	suspendedWithoutCondition := test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/started.json")
+	suspendedWithoutCondition.Spec.Suspend = ptr(true)
+
+	tests := []struct {
+		name      string
+		job       *batchv1.Job
+		want      bool
+		expectMsg string
+	}{
+		{
+			name: "Job started",
+			job:  test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/started.json"),
+			want: true,
+		},
+		{
+			name:      "Job suspended",
+			job:       test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/suspended.json"),
+			expectMsg: "[JobSuspended] Job suspended",
+		},
+		{
+			name:      "Job suspended before the controller observed it",
+			job:       suspendedWithoutCondition,
+			expectMsg: "Job is suspended",
+		},
+		{
+			name: "Job failed while suspended",
+			job:  failedWhileSuspended().Build(),
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jobNotSuspended(tt.job)
+			assert.Equal(t, tt.want, result.Ok)
+			assert.Equal(t, tt.expectMsg, result.Message.S)
+		})
+	}
+}
+
+func Test_Job_Checker_Failed_While_Suspended(t *testing.T) {
+	ready, result := NewJobChecker().ReadyStatus(failedWhileSuspended().Build())
+	assert.False(t, ready)
+	assert.True(t, result.Failed)
+	assert.Equal(t, "[DeadlineExceeded] Job was active longer than specified deadline", result.Message.S)
+}
+
+func Test_jobComplete_Failed(t *testing.T) {
+	tests := []struct {
+		name      string
+		job       *batchv1.Job
+		expectMsg string
+	}{
+		{
+			name:      "Backoff limit exceeded",
+			job:       test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/backoffLimit.json"),
+			expectMsg: "[BackoffLimitExceeded] Job has reached the specified backoff limit",
+		},
+		{
+			name: "Pod failure policy rule matched",
+			job:  test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/podFailurePolicyFailureTarget.json"),
+			expectMsg: "[PodFailurePolicy] Container main for pod default/train-5xk2q failed with exit code 42 " +
+				"matching FailJob rule at index 1\n" +
+				`Matched podFailurePolicy rule 1: action FailJob on exit code In [42] of container "main"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jobComplete(tt.job)
+			assert.True(t, result.Failed)
+			assert.Equal(t, tt.expectMsg, result.Message.S)
+		})
+	}
+}
+
+func Test_formatPodFailurePolicyRule(t *testing.T) {
+	rule := batchv1.PodFailurePolicyRule{
+		Action: batchv1.PodFailurePolicyActionIgnore,
+		OnPodConditions: []batchv1.PodFailurePolicyOnPodConditionsPattern{
+			{Type: corev1.DisruptionTarget, Status: corev1.ConditionTrue},
+		},
+	}
+	assert.Equal(t, "action Ignore on Pod condition DisruptionTarget=True", formatPodFailurePolicyRule(rule))
+}
+
+func Test_jobComplete_Indexed(t *testing.T) {
+	tooManyFailures := test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/indexedFailedIndexes.json")
+	tooManyFailures.Status.FailedIndexes = ptr("3,5,9")
+	tooManyFailures.Spec.MaxFailedIndexes = ptr(int32(2))
+
+	tests := []struct {
+		name         string
+		job          *batchv1.Job
+		expectFailed bool
+		expectMsg    string
+	}{
+		{
+			name:      "Indexes in progress",
+			job:       test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/indexedProgress.json"),
+			expectMsg: "4/10 indexes complete",
+		},
+		{
+			name:         "Indexes failed after reaching backoffLimitPerIndex",
+			job:          test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/indexedFailedIndexes.json"),
+			expectFailed: true,
+			expectMsg:    "7/10 indexes complete, failed: 3,5\nIndexes 3,5 failed after reaching backoffLimitPerIndex of 1",
+		},
+		{
+			name:         "Failed indexes exceed maxFailedIndexes",
+			job:          tooManyFailures,
+			expectFailed: true,
+			expectMsg:    "7/10 indexes complete, failed: 3,5,9\n3 failed indexes exceed maxFailedIndexes of 2",
+		},
+		{
+			name:         "Job failed with MaxFailedIndexesExceeded",
+			job:          test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/indexedMaxFailedIndexesExceeded.json"),
+			expectFailed: true,
+			expectMsg:    "[MaxFailedIndexesExceeded] Job has exceeded maxFailedIndexes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := jobComplete(tt.job)
+			assert.False(t, result.Ok)
+			assert.Equal(t, tt.expectFailed, result.Failed)
+			assert.Equal(t, tt.expectMsg, result.Message.S)
+		})
+	}
+}
+
+func Test_countIndexes(t *testing.T) {
+	for indexes, want := range map[string]int{"": 0, "3": 1, "0-2,4,6-8": 7, "1,3,5-6": 4} {
+		count, err := countIndexes(indexes)
+		require.NoError(t, err)
+		assert.Equal(t, want, count, indexes)
+	}
+
+	_, err := countIndexes("1,x-3")
+	assert.Error(t, err)
+}
+
 func Test_Job_Checker(t *testing.T) {
 	workflow := func(name string) string {
 		return workflowPath(name)
@@ -103,14 +250,19 @@ func Test_Job_Checker(t *testing.T) {
 		backoffLimitExceeded = "backoffLimitExceeded"
 		backoffLimitResolved = "backoffLimitResolved"
 		deadlineExceeded     = "deadlineExceeded"
+		indexedFailedIndexes = "indexedFailedIndexes"
+		indexedSuccessPolicy = "indexedSuccessPolicy"
+		podFailurePolicy     = "podFailurePolicy"
 		running              = "running"
 		succeeded            = "succeeded"
+		suspended            = "suspended"
 	)
 
 	tests := []struct {
 		name          string
 		workflowPaths []string
 		expectReady   bool
+		expectFailed  bool
 	}{
 		{
 			name:          "Job added but not running",
@@ -131,74 +283,128 @@ func Test_Job_Checker(t *testing.T) {
 			name:          "Job backoff limit exceeded",
 			workflowPaths: []string{workflow(backoffLimitExceeded)},
 			expectReady:   false,
+			expectFailed:  true,
 		},
 		{
 			name:          "Job deadline exceeded",
 			workflowPaths: []string{workflow(deadlineExceeded)},
 			expectReady:   false,
+			expectFailed:  true,
 		},
 		{
-			name:          "Job succeeded after backoff limit reached and resolved",
-			workflowPaths: []string{workflow(backoffLimitExceeded), workflow(backoffLimitResolved)},
+			name:          "Job replacement succeeded after backoff limit reached",
+			workflowPaths: []string{workflow(backoffLimitResolved)},
+			expectReady:   true,
+		},
+		{
+			name:          "Indexed Job with failed indexes",
+			workflowPaths: []string{workflow(indexedFailedIndexes)},
+			expectReady:   false,
+			expectFailed:  true,
+		},
+		{
+			name:          "Indexed Job with successPolicy met",
+			workflowPaths: []string{workflow(indexedSuccessPolicy)},
+			expectReady:   true,
+		},
+		{
+			name:          "Job failed by a podFailurePolicy rule",
+			workflowPaths: []string{workflow(podFailurePolicy)},
+			expectReady:   false,
+			expectFailed:  true,
+		},
+		{
+			name:          "Job resumed after being suspended",
+			workflowPaths: []string{workflow(suspended)},
 			expectReady:   true,
 		},
+		{
+			name:          "Job failure is terminal even if followed by a replacement",
+			workflowPaths: []string{workflow(backoffLimitExceeded), workflow(backoffLimitResolved)},
+			expectReady:   false,
+			expectFailed:  true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			jobChecker := NewJobChecker()
-
-			ready := false
-			var details checker.Results
-			jobStates := loadWorkflows(t, tt.workflowPaths...)
-			for _, jobState := range jobStates {
-				ready, details = jobChecker.ReadyDetails(jobState)
-				if ready {
-					break
-				}
+			jobStates := test.LoadWorkflowFixtures[batchv1.Job](t, tt.workflowPaths...)
+			result := test.AssertReplay(t, NewJobChecker(), jobStates).Result
+			if result.Ready() != tt.expectReady {
+				t.Errorf("Ready() = %t, want %t", result.Ready(), tt.expectReady)
 			}
-			fmt.Printf("Expect Ready() = %t\n", tt.expectReady)
-			fmt.Println(details)
-			if ready != tt.expectReady {
-				t.Errorf("Ready() = %t, want %t", ready, tt.expectReady)
+			if failed := result.Results.Status() == checker.StatusFailed; failed != tt.expectFailed {
+				t.Errorf("Failed = %t, want %t", failed, tt.expectFailed)
 			}
 		})
 	}
 }
 
+func Test_Job_With_Pods_Checker(t *testing.T) {
+	job := test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/started.json")
+	ownedPod := test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/jobImagePullError.json")
+	unownedPod := test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/ready.json")
+
+	tests := []struct {
+		name          string
+		state         *State
+		expectReady   bool
+		expectMessage string
+	}{
+		{
+			name:  "Job without Pods",
+			state: &State{Job: job},
+		},
+		{
+			name:  "Job with unowned Pods",
+			state: &State{Job: job, Pods: []*corev1.Pod{unownedPod}},
+		},
+		{
+			name:          "Job with failing Pod",
+			state:         &State{Job: job, Pods: []*corev1.Pod{unownedPod, ownedPod}},
+			expectMessage: `[Pod foo-x7k2p]: containers with unready status: [pi][ImagePullBackOff] Back-off pulling image "perl:5.40-invalid"`,
+		},
+		{
+			name: "Job backoff limit exceeded with failed Pod",
+			state: &State{
+				Job:  test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/backoffLimit.json"),
+				Pods: []*corev1.Pod{ownedPod, test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/jobContainerFailed.json")},
+			},
+			expectMessage: `[BackoffLimitExceeded] Job has reached the specified backoff limit
+[Pod foo-q9m4z]: containers with unready status: [pi]Container "pi" completed with exit code 2`,
+		},
+		{
+			name: "Job succeeded",
+			state: &State{
+				Job:  test.LoadFixture[batchv1.Job](t, "states/kubernetes/job/succeeded.json"),
+				Pods: []*corev1.Pod{ownedPod},
+			},
+			expectReady: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, result := NewJobWithPodsChecker().ReadyStatus(tt.state)
+			assert.Equal(t, tt.expectReady, ready)
+			assert.Equal(t, tt.expectMessage, result.Message.S)
+		})
+	}
+}
+
 //
 // Helpers
 //
 
-func loadJob(t *testing.T, statePath string) *batchv1.Job {
-	jsonBytes, err := internal.TestStates.ReadFile(statePath)
-	require.NoError(t, err)
-
-	state := test.MustLoadState(jsonBytes)
-	job := batchv1.Job{}
-	err = test.BuiltInScheme.Convert(state, &job, nil)
-	require.NoError(t, err)
-
-	return &job
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/job/%s.json", name)
 }
 
-func loadWorkflows(t *testing.T, workflowPaths ...string) []*batchv1.Job {
-	var jobs []*batchv1.Job
-	for _, workflowPath := range workflowPaths {
-		jsonBytes, err := internal.TestStates.ReadFile(workflowPath)
-		require.NoError(t, err)
-
-		states := test.MustLoadWorkflow(jsonBytes)
-		for _, state := range states {
-			job := batchv1.Job{}
-			err = test.BuiltInScheme.Convert(state, &job, nil)
-			require.NoError(t, err)
-			jobs = append(jobs, &job)
-		}
-	}
-
-	return jobs
+// failedWhileSuspended returns a Job that never started and failed while it was suspended.
+func failedWhileSuspended() *builder.JobBuilder {
+	return builder.NewJob("foo").Suspended().WithCondition(
+		batchv1.JobFailed, corev1.ConditionTrue, batchv1.JobReasonDeadlineExceeded,
+		"Job was active longer than specified deadline")
 }
 
-func workflowPath(name string) string {
-	return fmt.Sprintf("workflows/kubernetes/job/%s.json", name)
+func ptr[T any](v T) *T {
+	return &v
 }
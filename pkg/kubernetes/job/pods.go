@@ -0,0 +1,114 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package job
+
+import (
+	"strings"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/pod"
+	"github.com/pulumi/pulumi/sdk/v3/go/common/diag"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// legacyControllerUIDLabel is the unprefixed label that older versions of the Job controller set on Pods.
+const legacyControllerUIDLabel = "controller-uid"
+
+// State is a Job together with the Pods it owns.
+type State struct {
+	Job *batchv1.Job
+	// Pods that may be owned by the Job. Pods that are not owned by the Job are ignored.
+	Pods []*corev1.Pod
+}
+
+// NewJobWithPodsChecker returns a job checker that explains why a Job has not completed yet by including the warnings
+// and errors reported by the pod checker for each of the Job's Pods.
+func NewJobWithPodsChecker() *checker.StateChecker[*State] {
+	podChecker := pod.NewPodChecker()
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*State]{
+		Conditions: []checker.Condition[*State]{
+			func(state *State) checker.Result { return jobNotSuspended(state.Job) },
+			func(state *State) checker.Result { return jobStarted(state.Job) },
+			func(state *State) checker.Result { return jobCompleteWithPods(state, podChecker) },
+		},
+	})
+}
+
+//
+// Conditions
+//
+
+func jobCompleteWithPods(state *State, podChecker *checker.StateChecker[*corev1.Pod]) checker.Result {
+	result := jobComplete(state.Job)
+	if result.Ok {
+		return result
+	}
+
+	var messages []string
+	for _, p := range OwnedPods(state.Job, state.Pods) {
+		_, details := podChecker.ReadyDetails(p)
+		for _, message := range details.Messages().MessagesWithSeverity(diag.Warning, diag.Error) {
+			messages = append(messages, message.S)
+		}
+	}
+	if len(messages) == 0 {
+		return result
+	}
+
+	if result.Message.Empty() {
+		result.Message = logging.WarningMessage(strings.Join(messages, "\n"))
+	} else {
+		result.Message.S = strings.Join(append([]string{result.Message.S}, messages...), "\n")
+	}
+	return result
+}
+
+//
+// Helpers
+//
+
+// OwnedPods returns the Pods that are controlled by the Job, matched by controller owner reference or, for Pods
+// created before owner references were set, by the controller-uid label.
+func OwnedPods(job *batchv1.Job, pods []*corev1.Pod) []*corev1.Pod {
+	var owned []*corev1.Pod
+	for _, p := range pods {
+		if ownedBy(job, p) {
+			owned = append(owned, p)
+		}
+	}
+
+	return owned
+}
+
+func ownedBy(job *batchv1.Job, p *corev1.Pod) bool {
+	if kubernetes.IsControlledBy(p, job) {
+		return true
+	}
+	if len(job.UID) == 0 || metav1.GetControllerOfNoCopy(p) != nil {
+		return false
+	}
+
+	for _, label := range []string{batchv1.ControllerUidLabel, legacyControllerUIDLabel} {
+		if uid, ok := p.Labels[label]; ok {
+			return uid == string(job.UID)
+		}
+	}
+
+	return false
+}
@@ -17,6 +17,7 @@ package pod
 import (
 	"errors"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -26,18 +27,52 @@ import (
 	corev1 "k8s.io/api/core/v1"
 )
 
-func NewPodChecker() *checker.StateChecker {
-	return checker.NewStateChecker(&checker.StateCheckerArgs{
-		Conditions: []checker.Condition{podScheduled, podInitialized, podReady},
+const oomKilledReason = "OOMKilled"
+
+// ReadinessGateFormatter returns a diagnostic for a readiness gate whose condition is missing or not true. The
+// condition is nil if it has not been set on the Pod yet. An empty diagnostic falls back to the default message.
+type ReadinessGateFormatter func(pod *corev1.Pod, gate corev1.PodReadinessGate, condition *corev1.PodCondition) string
+
+// PodCheckerArgs configures the checker returned by NewPodCheckerWithArgs.
+type PodCheckerArgs struct {
+	// ReadinessGateFormatters are diagnostic formatters for readiness gates, keyed by the gate's condition type or by
+	// the prefix of the condition type before the "/", e.g., "target-health.elbv2.k8s.aws".
+	ReadinessGateFormatters map[corev1.PodConditionType]ReadinessGateFormatter
+}
+
+func NewPodChecker() *checker.StateChecker[*corev1.Pod] {
+	return NewPodCheckerWithArgs(nil)
+}
+
+// NewPodCheckerWithArgs returns a pod checker configured with the provided args.
+func NewPodCheckerWithArgs(args *PodCheckerArgs) *checker.StateChecker[*corev1.Pod] {
+	var formatters map[corev1.PodConditionType]ReadinessGateFormatter
+	if args != nil {
+		formatters = args.ReadinessGateFormatters
+	}
+
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*corev1.Pod]{
+		Conditions: []checker.Condition[*corev1.Pod]{
+			podScheduled,
+			podInitialized,
+			func(pod *corev1.Pod) checker.Result {
+				return podReadyWithGates(pod, formatters)
+			},
+		},
 	})
 }
 
+// NewUntypedPodChecker returns a pod checker for callers that pass states as interface{}. States may be either
+// *corev1.Pod or *unstructured.Unstructured.
+func NewUntypedPodChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewPodChecker(), kubernetes.FromUnstructured[corev1.Pod])
+}
+
 //
 // Conditions
 //
 
-func podScheduled(obj interface{}) checker.Result {
-	pod := obj.(*corev1.Pod)
+func podScheduled(pod *corev1.Pod) checker.Result {
 	result := checker.Result{Description: fmt.Sprintf(
 		"Waiting for Pod %q to be scheduled", kubernetes.FullyQualifiedName(pod))}
 
@@ -47,6 +82,10 @@ func podScheduled(obj interface{}) checker.Result {
 			result.Ok = true
 		default:
 			msg := statusFromCondition(condition)
+			if failure, ok := ParseSchedulingFailure(pod, msg); ok {
+				result.Data = failure
+				msg = strings.Join(append([]string{msg}, failure.Summary...), "\n")
+			}
 			if len(msg) > 0 {
 				result.Message = logging.StatusMessage(msg)
 			}
@@ -56,8 +95,7 @@ func podScheduled(obj interface{}) checker.Result {
 	return result
 }
 
-func podInitialized(obj interface{}) checker.Result {
-	pod := obj.(*corev1.Pod)
+func podInitialized(pod *corev1.Pod) checker.Result {
 	result := checker.Result{Description: fmt.Sprintf(
 		"Waiting for Pod %q to be initialized", kubernetes.FullyQualifiedName(pod))}
 
@@ -67,19 +105,31 @@ func podInitialized(obj interface{}) checker.Result {
 	}
 
 	if initialized.Status == corev1.ConditionTrue {
-		result.Ok = true
+		// Native sidecars must also be ready, unless the Pod has already finished running.
+		err := collectInitContainerErrors(pod)
+		if err == nil || pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed {
+			result.Ok = true
+			return result
+		}
+
+		result.Message = logging.StatusMessage(
+			fmt.Sprintf("[Pod %s]: %s", kubernetes.FullyQualifiedName(pod), err))
 		return result
 	}
 
-	err := collectContainerStatusErrors(pod.Status.ContainerStatuses)
+	err := errors.Join(collectInitContainerErrors(pod), collectPodErrors(pod))
 	if err != nil || len(initialized.Message) > 0 {
 		result.Message = logging.WarningMessage(podError(initialized, err, kubernetes.FullyQualifiedName(pod)))
 	}
+	markFailedPermanently(pod, &result)
 	return result
 }
 
-func podReady(obj interface{}) checker.Result {
-	pod := obj.(*corev1.Pod)
+func podReady(pod *corev1.Pod) checker.Result {
+	return podReadyWithGates(pod, nil)
+}
+
+func podReadyWithGates(pod *corev1.Pod, formatters map[corev1.PodConditionType]ReadinessGateFormatter) checker.Result {
 	result := checker.Result{Description: fmt.Sprintf(
 		"Waiting for Pod %q to be ready", kubernetes.FullyQualifiedName(pod))}
 
@@ -93,10 +143,17 @@ func podReady(obj interface{}) checker.Result {
 		return result
 	}
 
-	err := collectContainerStatusErrors(pod.Status.ContainerStatuses)
+	gateErr := collectReadinessGateErrors(pod, formatters)
+	if gateErr != nil && ready.Reason == "ReadinessGatesNotReady" {
+		// The readiness gate errors supersede the kubelet's summary of them.
+		ready = &corev1.PodCondition{Type: ready.Type, Status: ready.Status}
+	}
+
+	err := errors.Join(collectPodErrors(pod), gateErr)
 	if err != nil || len(ready.Message) > 0 {
 		result.Message = logging.WarningMessage(podError(ready, err, kubernetes.FullyQualifiedName(pod)))
 	}
+	markFailedPermanently(pod, &result)
 	return result
 }
 
@@ -104,24 +161,202 @@ func podReady(obj interface{}) checker.Result {
 // Helpers
 //
 
-func collectContainerStatusErrors(statuses []corev1.ContainerStatus) error {
+// markFailedPermanently marks the result as failed if the Pod or any of its containers can never become ready, and
+// raises the severity of the result's message accordingly.
+func markFailedPermanently(pod *corev1.Pod, result *checker.Result) {
+	// Failed Pods, e.g., evicted ones, are never restarted.
+	if pod.Status.Phase == corev1.PodFailed {
+		result.Failed = true
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		if containerFailedPermanently(pod, status) {
+			result.Failed = true
+			break
+		}
+	}
+	for _, status := range pod.Status.InitContainerStatuses {
+		// Native sidecars are restarted regardless of the Pod's restartPolicy.
+		if kubernetes.IsSidecar(findContainer(pod, status.Name)) && status.State.Terminated != nil {
+			continue
+		}
+		if containerFailedPermanently(pod, status) {
+			result.Failed = true
+			break
+		}
+	}
+
+	if result.Failed && !result.Message.Empty() {
+		result.Message = logging.ErrorMessage(result.Message.S)
+	}
+}
+
+// containerFailedPermanently returns true if the container is in a state that will not change without the Pod being
+// replaced.
+func containerFailedPermanently(pod *corev1.Pod, status corev1.ContainerStatus) bool {
+	if waiting := status.State.Waiting; waiting != nil {
+		switch waiting.Reason {
+		case "ErrImageNeverPull", "InvalidImageName":
+			return true
+		}
+	}
+
+	// Containers are never restarted with restartPolicy: Never, so a failed container stays failed.
+	if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode != 0 {
+		return pod.Spec.RestartPolicy == corev1.RestartPolicyNever
+	}
+
+	return false
+}
+
+func collectPodErrors(pod *corev1.Pod) error {
+	return errors.Join(podEvictedError(pod), collectContainerStatusErrors(pod, pod.Status.ContainerStatuses))
+}
+
+// podEvictedError explains why the kubelet evicted the Pod, if it did.
+func podEvictedError(pod *corev1.Pod) error {
+	if pod.Status.Reason != "Evicted" {
+		return nil
+	}
+
+	err := fmt.Errorf("[%s] %s", pod.Status.Reason, strings.TrimSpace(pod.Status.Message))
+	if strings.Contains(pod.Status.Message, string(corev1.ResourceEphemeralStorage)) {
+		err = errors.Join(err, errors.New("Pod was evicted for its ephemeral-storage usage; "+
+			"set resources.requests and resources.limits for ephemeral-storage, "+
+			"or write large files to a persistent volume instead of the container filesystem or an emptyDir"))
+	}
+	return err
+}
+
+// collectInitContainerErrors returns errors for the init container that is blocking the Pod's initialization, if any,
+// and for native sidecars that are not started or, if they have a readiness probe, not ready. Init containers run one
+// at a time, so the containers after the first incomplete one are not reported.
+func collectInitContainerErrors(pod *corev1.Pod) error {
+	var err error
+	for _, status := range pod.Status.InitContainerStatuses {
+		container := findContainer(pod, status.Name)
+		if kubernetes.IsSidecar(container) {
+			err = errors.Join(err, sidecarError(pod, container, status))
+			continue
+		}
+
+		if terminated := status.State.Terminated; terminated != nil && terminated.ExitCode == 0 {
+			continue
+		}
+		return errors.Join(err, initContainerError(pod, status))
+	}
+
+	return err
+}
+
+func initContainerError(pod *corev1.Pod, status corev1.ContainerStatus) error {
+	err := containerWaitingError(status)
+
+	terminated := status.State.Terminated
+	if terminated == nil {
+		terminated = status.LastTerminationState.Terminated
+	}
+	if terminated == nil || terminated.ExitCode == 0 {
+		return err
+	}
+
+	err = errors.Join(err, fmt.Errorf("Init container %q failed (exit code %d)", status.Name, terminated.ExitCode))
+	if terminated.Message != "" {
+		err = errors.Join(err, errors.New(terminated.Message))
+	}
+	if terminated.Reason == oomKilledReason {
+		err = errors.Join(err, oomKilledError(pod, status.Name))
+	}
+	return err
+}
+
+func sidecarError(pod *corev1.Pod, container *corev1.Container, status corev1.ContainerStatus) error {
+	if status.Started == nil || !*status.Started {
+		return errors.Join(
+			fmt.Errorf("Sidecar container %q has not started", status.Name),
+			containerWaitingError(status),
+			containerLastTerminationState(pod, status))
+	}
+	if container.ReadinessProbe != nil && !status.Ready {
+		return fmt.Errorf("Sidecar container %q is not ready", status.Name)
+	}
+
+	return nil
+}
+
+// collectReadinessGateErrors returns an error for each of the Pod's readiness gates whose condition is missing or not
+// true, formatted by the matching formatter if there is one.
+func collectReadinessGateErrors(
+	pod *corev1.Pod, formatters map[corev1.PodConditionType]ReadinessGateFormatter,
+) error {
+	var err error
+	for _, gate := range pod.Spec.ReadinessGates {
+		condition, found := filterConditions(pod.Status.Conditions, gate.ConditionType)
+		if found && condition.Status == corev1.ConditionTrue {
+			continue
+		}
+		if !found {
+			condition = nil
+		}
+
+		var msg string
+		if format := readinessGateFormatter(formatters, gate.ConditionType); format != nil {
+			msg = format(pod, gate, condition)
+		}
+		if len(msg) == 0 {
+			msg = readinessGateMsg(gate, condition)
+		}
+		err = errors.Join(err, errors.New(msg))
+	}
+
+	return err
+}
+
+func readinessGateFormatter(
+	formatters map[corev1.PodConditionType]ReadinessGateFormatter, conditionType corev1.PodConditionType,
+) ReadinessGateFormatter {
+	if format, ok := formatters[conditionType]; ok {
+		return format
+	}
+	if prefix, _, ok := strings.Cut(string(conditionType), "/"); ok {
+		return formatters[corev1.PodConditionType(prefix)]
+	}
+
+	return nil
+}
+
+func readinessGateMsg(gate corev1.PodReadinessGate, condition *corev1.PodCondition) string {
+	if condition == nil {
+		return fmt.Sprintf("Readiness gate %q has no condition yet", gate.ConditionType)
+	}
+
+	msg := fmt.Sprintf("Readiness gate %q is %q", gate.ConditionType, condition.Status)
+	switch {
+	case len(condition.Reason) > 0:
+		msg += strings.TrimSpace(fmt.Sprintf(": [%s] %s", condition.Reason, condition.Message))
+	case len(condition.Message) > 0:
+		msg += ": " + condition.Message
+	}
+	return msg
+}
+
+func collectContainerStatusErrors(pod *corev1.Pod, statuses []corev1.ContainerStatus) error {
 	var err error
 	for _, status := range statuses {
-		err = errors.Join(err, containerStatusErrors(status))
+		err = errors.Join(err, containerStatusErrors(pod, status))
 	}
 
 	return err
 }
 
-func containerStatusErrors(status corev1.ContainerStatus) error {
+func containerStatusErrors(pod *corev1.Pod, status corev1.ContainerStatus) error {
 	if status.Ready {
 		return nil
 	}
 
 	var err error
 	err = errors.Join(err, containerWaitingError(status))
-	err = errors.Join(err, containerTerminatedError(status))
-	err = errors.Join(err, containerLastTerminationState(status))
+	err = errors.Join(err, containerTerminatedError(pod, status))
+	err = errors.Join(err, containerLastTerminationState(pod, status))
 	return err
 }
 
@@ -131,15 +366,21 @@ func containerWaitingError(status corev1.ContainerStatus) error {
 		return nil
 	}
 
-	// Return no error if the container is creating.
-	if state.Reason == "ContainerCreating" {
+	// Return no error if the container is creating or waiting for the Pod to be initialized.
+	if state.Reason == "ContainerCreating" || state.Reason == "PodInitializing" {
 		return nil
 	}
 
+	if state.Reason == "CreateContainerConfigError" {
+		if msg, ok := missingConfigMsg(status.Name, state.Message); ok {
+			return fmt.Errorf("[%s] %s", state.Reason, msg)
+		}
+	}
+
 	return fmt.Errorf("[%s] %s", state.Reason, trimImagePullMsg(state.Message))
 }
 
-func containerTerminatedError(status corev1.ContainerStatus) error {
+func containerTerminatedError(pod *corev1.Pod, status corev1.ContainerStatus) error {
 	state := status.State.Terminated
 	if state == nil {
 		return nil
@@ -150,13 +391,19 @@ func containerTerminatedError(status corev1.ContainerStatus) error {
 		return nil
 	}
 
+	if state.Reason == oomKilledReason {
+		return errors.Join(
+			fmt.Errorf("Container %q was OOMKilled (exit code %d)", status.Name, state.ExitCode),
+			oomKilledError(pod, status.Name))
+	}
+
 	if len(state.Message) > 0 {
 		return fmt.Errorf("[%s] %s", state.Reason, trimImagePullMsg(state.Message))
 	}
 	return fmt.Errorf("Container %q completed with exit code %d", status.Name, state.ExitCode)
 }
 
-func containerLastTerminationState(status corev1.ContainerStatus) error {
+func containerLastTerminationState(pod *corev1.Pod, status corev1.ContainerStatus) error {
 	terminated := status.LastTerminationState.Terminated
 	if terminated == nil {
 		return nil
@@ -169,9 +416,62 @@ func containerLastTerminationState(status corev1.ContainerStatus) error {
 	if terminated.Message != "" {
 		err = errors.Join(err, errors.New(terminated.Message))
 	}
+	if terminated.Reason == oomKilledReason {
+		err = errors.Join(err, oomKilledError(pod, status.Name))
+	}
 	return err
 }
 
+// oomKilledError explains an OOMKilled container in terms of the memory limit from its spec. Without a limit, the
+// container was killed because the node itself ran out of memory.
+func oomKilledError(pod *corev1.Pod, containerName string) error {
+	container := findContainer(pod, containerName)
+	if container != nil {
+		if limit, ok := container.Resources.Limits[corev1.ResourceMemory]; ok {
+			return fmt.Errorf("Container %q exceeded its memory limit of %s; "+
+				"increase resources.limits.memory or reduce its memory usage", containerName, limit.String())
+		}
+	}
+
+	return fmt.Errorf("Container %q has no memory limit and was killed because the node ran out of memory; "+
+		"set resources.requests.memory so that it is scheduled on a node with enough memory", containerName)
+}
+
+var (
+	missingConfigRegexp    = regexp.MustCompile(`^(secret|configmap) "([^"]+)" not found$`)
+	missingConfigKeyRegexp = regexp.MustCompile(`^couldn't find key (\S+) in (Secret|ConfigMap) ([^/\s]+)/(\S+)$`)
+)
+
+// missingConfigMsg rewrites the kubelet's CreateContainerConfigError messages for a missing Secret or ConfigMap, or a
+// missing key in one, to name the container and the object it references.
+func missingConfigMsg(containerName, msg string) (string, bool) {
+	if m := missingConfigRegexp.FindStringSubmatch(msg); m != nil {
+		kind := "Secret"
+		if m[1] == "configmap" {
+			kind = "ConfigMap"
+		}
+		return fmt.Sprintf("Container %q references %s %q, which does not exist", containerName, kind, m[2]), true
+	}
+	if m := missingConfigKeyRegexp.FindStringSubmatch(msg); m != nil {
+		return fmt.Sprintf("Container %q references key %q, which does not exist in %s %q",
+			containerName, m[1], m[2], m[4]), true
+	}
+
+	return "", false
+}
+
+func findContainer(pod *corev1.Pod, name string) *corev1.Container {
+	for _, containers := range [][]corev1.Container{pod.Spec.Containers, pod.Spec.InitContainers} {
+		for i := range containers {
+			if containers[i].Name == name {
+				return &containers[i]
+			}
+		}
+	}
+
+	return nil
+}
+
 // trimImagePullMsg trims unhelpful error from ImagePullError status messages.
 func trimImagePullMsg(msg string) string {
 	msg = strings.TrimPrefix(msg, "rpc error: code = Unknown desc = Error response from daemon: ")
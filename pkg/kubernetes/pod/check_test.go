@@ -15,17 +15,24 @@
 package pod
 
 import (
+	"flag"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pulumi/cloud-ready-checks/internal"
 	"github.com/pulumi/cloud-ready-checks/pkg/checker"
 	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test/builder"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 )
 
+// The -update flag rewrites the golden files of the replayed workflows, e.g., `go test ./pkg/kubernetes/pod -update`.
+var _ = flag.Bool(test.UpdateGoldenFlag, false, "update the golden files of replayed workflows")
+
 //
 // Test Conditions
 //
@@ -46,10 +53,15 @@ func Test_podInitialized(t *testing.T) {
 			"states/kubernetes/pod/uninitialized.json",
 			false,
 		},
+		{
+			"Pod initialized with a sidecar that is not ready",
+			"states/kubernetes/pod/sidecarNotReady.json",
+			false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pod := loadPod(t, tt.testStatePath)
+			pod := test.LoadFixture[corev1.Pod](t, tt.testStatePath)
 			if got := podInitialized(pod); got.Ok != tt.want {
 				t.Errorf("podInitialized() = %v, want %v", got.Ok, tt.want)
 			}
@@ -81,7 +93,7 @@ func Test_podReady(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pod := loadPod(t, tt.testStatePath)
+			pod := test.LoadFixture[corev1.Pod](t, tt.testStatePath)
 			if got := podReady(pod); got.Ok != tt.want {
 				t.Errorf("podReady() = %v, want %v", got.Ok, tt.want)
 			}
@@ -108,7 +120,7 @@ func Test_podScheduled(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			pod := loadPod(t, tt.testStatePath)
+			pod := test.LoadFixture[corev1.Pod](t, tt.testStatePath)
 			if got := podScheduled(pod); got.Ok != tt.want {
 				t.Errorf("podScheduled() = %v, want %v", got.Ok, tt.want)
 			}
@@ -116,6 +128,313 @@ func Test_podScheduled(t *testing.T) {
 	}
 }
 
+func Test_podScheduled_Failure(t *testing.T) {
+	result := podScheduled(test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/unschedulable.json"))
+	assert.False(t, result.Ok)
+	assert.Equal(t, `0/5 nodes are available: 1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }, `+
+		`2 Insufficient cpu, 1 node(s) didn't match Pod's node affinity/selector, 1 node(s) had volume node affinity conflict. `+
+		`preemption: 0/5 nodes are available: 1 Preemption is not helpful for scheduling, 4 No preemption victims found for incoming pod.
+1 node(s) have the taint {node-role.kubernetes.io/control-plane: }, which the Pod doesn't tolerate
+2 node(s) have insufficient cpu for the Pod's request of 2
+1 node(s) don't match the Pod's node affinity or nodeSelector (nodeSelector: disktype=ssd, kubernetes.io/arch=arm64)
+1 node(s) can't access the Pod's PersistentVolumes`, result.Message.S)
+
+	failure, ok := result.Data.(*SchedulingFailure)
+	require.True(t, ok)
+	assert.Equal(t, 0, failure.AvailableNodes)
+	assert.Equal(t, 5, failure.TotalNodes)
+	assert.Equal(t, []SchedulingCondition{
+		{
+			Reason:  SchedulingUntoleratedTaint,
+			Nodes:   1,
+			Taint:   "{node-role.kubernetes.io/control-plane: }",
+			Message: "1 node(s) had untolerated taint {node-role.kubernetes.io/control-plane: }",
+		},
+		{Reason: SchedulingInsufficientResource, Nodes: 2, Resource: corev1.ResourceCPU, Message: "2 Insufficient cpu"},
+		{Reason: SchedulingNodeAffinity, Nodes: 1, Message: "1 node(s) didn't match Pod's node affinity/selector"},
+		{Reason: SchedulingVolumeNodeAffinityConflict, Nodes: 1, Message: "1 node(s) had volume node affinity conflict"},
+	}, failure.Reasons)
+}
+
+func Test_ParseSchedulingFailure(t *testing.T) {
+	pod := test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/unschedulable.json")
+
+	tests := []struct {
+		name        string
+		msg         string
+		wantReasons []SchedulingReason
+		wantSummary []string
+	}{
+		{
+			"Insufficient memory",
+			"0/3 nodes are available: 3 Insufficient memory. preemption: 0/3 nodes are available: " +
+				"3 No preemption victims found for incoming pod.",
+			[]SchedulingReason{SchedulingInsufficientResource},
+			[]string{"3 node(s) have insufficient memory for the Pod's request of 4224Mi"},
+		},
+		{
+			"Unbound PersistentVolumeClaims",
+			"0/3 nodes are available: pod has unbound immediate PersistentVolumeClaims. preemption: " +
+				"0/3 nodes are available: 3 Preemption is not helpful for scheduling.",
+			[]SchedulingReason{SchedulingUnboundPersistentVolume},
+			[]string{`The Pod's PersistentVolumeClaims "worker-data" are not bound`},
+		},
+		{
+			"Topology spread and other reasons",
+			"0/4 nodes are available: 2 Too many pods, 2 node(s) didn't match pod topology spread constraints.",
+			[]SchedulingReason{SchedulingOther, SchedulingTopologySpread},
+			[]string{"2 Too many pods", "2 node(s) don't satisfy the Pod's topology spread constraints"},
+		},
+		{
+			"Taint reported by older schedulers",
+			"0/2 nodes are available: 2 node(s) had taint {dedicated: gpu}, that the pod didn't tolerate.",
+			[]SchedulingReason{SchedulingUntoleratedTaint},
+			[]string{"2 node(s) have the taint {dedicated: gpu}, which the Pod doesn't tolerate"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failure, ok := ParseSchedulingFailure(pod, tt.msg)
+			require.True(t, ok)
+			var reasons []SchedulingReason
+			for _, condition := range failure.Reasons {
+				reasons = append(reasons, condition.Reason)
+			}
+			assert.Equal(t, tt.wantReasons, reasons)
+			assert.Equal(t, tt.wantSummary, failure.Summary)
+		})
+	}
+
+	_, ok := ParseSchedulingFailure(pod, "Pod is waiting for a node")
+	assert.False(t, ok)
+}
+
+func Test_podRequests(t *testing.T) {
+	cpu := func(name, request string) corev1.Container {
+		return corev1.Container{Name: name, Resources: corev1.ResourceRequirements{
+			Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(request)}}}
+	}
+	sidecar := func(name, request string) corev1.Container {
+		container := cpu(name, request)
+		container.RestartPolicy = ptr(corev1.ContainerRestartPolicyAlways)
+		return container
+	}
+
+	tests := []struct {
+		name           string
+		initContainers []corev1.Container
+		containers     []corev1.Container
+		want           string
+	}{
+		{
+			"Containers",
+			nil,
+			[]corev1.Container{cpu("web", "500m"), cpu("proxy", "250m")},
+			"750m",
+		},
+		{
+			"Init container larger than the containers",
+			[]corev1.Container{cpu("migrate", "2")},
+			[]corev1.Container{cpu("web", "500m")},
+			"2",
+		},
+		{
+			"Init container after a sidecar",
+			[]corev1.Container{sidecar("proxy", "1"), cpu("migrate", "2")},
+			[]corev1.Container{cpu("web", "500m")},
+			"3",
+		},
+		{
+			"Init container before a sidecar",
+			[]corev1.Container{cpu("migrate", "2"), sidecar("proxy", "1")},
+			[]corev1.Container{cpu("web", "500m")},
+			"2",
+		},
+		{
+			"Containers and sidecars larger than the init containers",
+			[]corev1.Container{sidecar("proxy", "1"), cpu("migrate", "500m")},
+			[]corev1.Container{cpu("web", "1")},
+			"2",
+		},
+		{
+			"Limit only",
+			nil,
+			[]corev1.Container{{Name: "web", Resources: corev1.ResourceRequirements{
+				Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1500m")}}}},
+			"1500m",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := &corev1.Pod{Spec: corev1.PodSpec{InitContainers: tt.initContainers, Containers: tt.containers}}
+			request := podRequests(pod)[corev1.ResourceCPU]
+			assert.Equal(t, tt.want, request.String())
+		})
+	}
+}
+
+func Test_podReadyWithGates(t *testing.T) {
+	targetHealth := func(pod *corev1.Pod, gate corev1.PodReadinessGate, condition *corev1.PodCondition) string {
+		_, targetGroup, _ := strings.Cut(string(gate.ConditionType), "/")
+		if condition == nil {
+			return fmt.Sprintf("Pod is not registered with target group %q yet", targetGroup)
+		}
+		return fmt.Sprintf("Target group %q reports %s", targetGroup, condition.Message)
+	}
+
+	tests := []struct {
+		name          string
+		testStatePath string
+		formatters    map[corev1.PodConditionType]ReadinessGateFormatter
+		wantMessage   string
+	}{
+		{
+			"Readiness gate condition missing",
+			"states/kubernetes/pod/readinessGateMissing.json",
+			nil,
+			`[Pod web]: Readiness gate "target-health.elbv2.k8s.aws/k8s-default-web-7c4f1e2a9b" has no condition yet`,
+		},
+		{
+			"Readiness gate condition false",
+			"states/kubernetes/pod/readinessGateFalse.json",
+			nil,
+			`[Pod web]: Readiness gate "target-health.elbv2.k8s.aws/k8s-default-web-7c4f1e2a9b" is "False": ` +
+				`[Target.ResponseCodeMismatch] Health checks failed with these codes: [404]`,
+		},
+		{
+			"Readiness gate condition missing with a formatter",
+			"states/kubernetes/pod/readinessGateMissing.json",
+			map[corev1.PodConditionType]ReadinessGateFormatter{"target-health.elbv2.k8s.aws": targetHealth},
+			`[Pod web]: Pod is not registered with target group "k8s-default-web-7c4f1e2a9b" yet`,
+		},
+		{
+			"Readiness gate condition false with a formatter",
+			"states/kubernetes/pod/readinessGateFalse.json",
+			map[corev1.PodConditionType]ReadinessGateFormatter{
+				"target-health.elbv2.k8s.aws/k8s-default-web-7c4f1e2a9b": targetHealth,
+			},
+			`[Pod web]: Target group "k8s-default-web-7c4f1e2a9b" reports Health checks failed with these codes: [404]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := podReadyWithGates(test.LoadFixture[corev1.Pod](t, tt.testStatePath), tt.formatters)
+			assert.False(t, result.Ok)
+			assert.Equal(t, tt.wantMessage, result.Message.S)
+		})
+	}
+}
+
+func Test_collectInitContainerErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		pod  *corev1.Pod
+		want string
+	}{
+		{
+			"Init container in a crash loop",
+			test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/initContainerCrashLoopBackOff.json"),
+			`[CrashLoopBackOff] back-off 10s restarting failed container=migrate pod=web_default(2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e)
+Init container "migrate" failed (exit code 2)
+error: relation "users" already exists`,
+		},
+		{
+			"Init container failed with restartPolicy: Never",
+			test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/initContainerFailedRestartNever.json"),
+			`Init container "migrate" failed (exit code 2)
+error: relation "users" already exists`,
+		},
+		{
+			"Sidecar not started",
+			test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/sidecarNotStarted.json"),
+			`Sidecar container "proxy" has not started`,
+		},
+		{
+			"Sidecar not ready",
+			test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/sidecarNotReady.json"),
+			`Sidecar container "proxy" is not ready`,
+		},
+		{
+			"Only the first incomplete init container is reported",
+			builder.NewPod("web").Scheduled().
+				WithInitContainer("wait-for-db").Terminated(0, "Completed", "").
+				WithInitContainer("migrate").Restarted(1, "Error", "").Waiting("CrashLoopBackOff", "back-off 20s").
+				WithInitContainer("seed").
+				WithContainer("web").
+				Build(),
+			`[CrashLoopBackOff] back-off 20s
+Init container "migrate" failed (exit code 1)`,
+		},
+		{
+			"Init container OOMKilled",
+			builder.NewPod("web").Scheduled().WithRestartPolicy(corev1.RestartPolicyNever).
+				WithInitContainer("migrate").WithMemoryLimit("32Mi").Terminated(137, "OOMKilled", "").
+				WithContainer("web").
+				Build(),
+			`Init container "migrate" failed (exit code 137)
+Container "migrate" exceeded its memory limit of 32Mi; increase resources.limits.memory or reduce its memory usage`,
+		},
+		{
+			"Sidecar restarting before a failed init container",
+			builder.NewPod("web").Scheduled().
+				WithSidecar("proxy").Restarted(1, "Error", "").Waiting("CrashLoopBackOff", "back-off 10s").
+				WithInitContainer("migrate").Waiting("ImagePullBackOff", `Back-off pulling image "migrate"`).
+				WithContainer("web").
+				Build(),
+			`Sidecar container "proxy" has not started
+[CrashLoopBackOff] back-off 10s
+Container "proxy" terminated at 2026-01-01T00:00:00Z (Error: exit code 1)
+[ImagePullBackOff] Back-off pulling image "migrate"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := collectInitContainerErrors(tt.pod)
+			require.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
+func Test_collectPodErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          string
+	}{
+		{
+			"Container OOMKilled without a memory limit",
+			"states/kubernetes/pod/oomKilledNoLimit.json",
+			`Container "report" was OOMKilled (exit code 137)
+Container "report" has no memory limit and was killed because the node ran out of memory; set resources.requests.memory so that it is scheduled on a node with enough memory`,
+		},
+		{
+			"Pod evicted for ephemeral-storage",
+			"states/kubernetes/pod/evicted.json",
+			`[Evicted] The node was low on resource: ephemeral-storage. Threshold quantity: 1Gi, available: 524Mi. Container web was using 3Gi, request is 0, has larger consumption of ephemeral-storage.
+Pod was evicted for its ephemeral-storage usage; set resources.requests and resources.limits for ephemeral-storage, or write large files to a persistent volume instead of the container filesystem or an emptyDir
+[ContainerStatusUnknown] The container could not be located when the pod was terminated`,
+		},
+		{
+			"Container references a missing Secret",
+			"states/kubernetes/pod/secretNotFound.json",
+			`[CreateContainerConfigError] Container "app" references Secret "db-credentials", which does not exist`,
+		},
+		{
+			"Container references a missing ConfigMap key",
+			"states/kubernetes/pod/configMapKeyNotFound.json",
+			`[CreateContainerConfigError] Container "app" references key "LOG_LEVEL", which does not exist in ConfigMap "app-config"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := collectPodErrors(test.LoadFixture[corev1.Pod](t, tt.testStatePath))
+			require.Error(t, err)
+			assert.Equal(t, tt.want, err.Error())
+		})
+	}
+}
+
 //
 // Test Pod State Checker using recorded events.
 //
@@ -127,9 +446,11 @@ func Test_Pod_Checker(t *testing.T) {
 	const (
 		added                                     = "added"
 		containerTerminatedError                  = "containerTerminatedError"
+		containerTerminatedErrorRestartNever      = "containerTerminatedErrorRestartNever"
 		containerTerminatedSuccess                = "containerTerminatedSuccess"
 		containerTerminatedSuccessRestartNever    = "containerTerminatedSuccessRestartNever"
 		createSuccess                             = "createSuccess"
+		imageNeverPull                            = "imageNeverPull"
 		imagePullError                            = "imagePullError"
 		imagePullErrorResolved                    = "imagePullErrorResolved"
 		scheduled                                 = "scheduled"
@@ -138,12 +459,19 @@ func Test_Pod_Checker(t *testing.T) {
 		unscheduled                               = "unscheduled"
 		crashLoopBackoff                          = "crashLoopBackoff"
 		crashLoopBackoffWithFallbackToLogsOnError = "crashLoopBackoffWithFallbackToLogsOnError"
+		oomKilled                                 = "oomKilled"
+		evicted                                   = "evicted"
+		createContainerConfigError                = "createContainerConfigError"
+		initContainerFailed                       = "initContainerFailed"
+		sidecar                                   = "sidecar"
+		readinessGate                             = "readinessGate"
 	)
 
 	tests := []struct {
 		name          string
 		workflowPaths []string
 		expectReady   bool
+		expectFailed  bool
 		expectMessage string
 	}{
 		{
@@ -226,6 +554,22 @@ Container "nginx" terminated at 2019-06-25T20:55:54Z (Completed: exit code 0)
 			workflowPaths: []string{workflow(containerTerminatedSuccessRestartNever)},
 			expectReady:   true,
 		},
+		{
+			name:          "Pod container terminated with error with restartPolicy: Never",
+			workflowPaths: []string{workflow(containerTerminatedErrorRestartNever)},
+			expectReady:   false,
+			expectFailed:  true,
+			expectMessage: `["failed"] Waiting for Pod "foo" to be ready -- [Pod foo]: Container "nginx" completed with exit code 1
+`,
+		},
+		{
+			name:          "Pod image never pulled",
+			workflowPaths: []string{workflow(imageNeverPull)},
+			expectReady:   false,
+			expectFailed:  true,
+			expectMessage: `[Pod foo]: containers with unready status: [nginx][ErrImageNeverPull] Container image "nginx:1.13-invalid" is not present with pull policy of Never
+`,
+		},
 		{
 			name:          "crashLoopBackoff",
 			workflowPaths: []string{workflow(crashLoopBackoff)},
@@ -242,63 +586,84 @@ Container "crash" terminated at 2024-07-03T17:47:36Z (Error: exit code 1)
 see ya!
 `,
 		},
+		{
+			name:          "Pod container OOMKilled",
+			workflowPaths: []string{workflow(oomKilled)},
+			expectReady:   false,
+			expectMessage: `Container "api" terminated at 2026-03-10T09:22:03Z (OOMKilled: exit code 137)
+Container "api" exceeded its memory limit of 128Mi; increase resources.limits.memory or reduce its memory usage
+`,
+		},
+		{
+			name:          "Pod evicted",
+			workflowPaths: []string{workflow(evicted)},
+			expectReady:   false,
+			expectFailed:  true,
+			expectMessage: `["failed"] Waiting for Pod "web" to be ready -- [Pod web]: [Evicted] The node was low on resource: ephemeral-storage.`,
+		},
+		{
+			name:          "Pod container config references a missing ConfigMap key",
+			workflowPaths: []string{workflow(createContainerConfigError)},
+			expectReady:   false,
+			expectMessage: `[CreateContainerConfigError] Container "app" references key "LOG_LEVEL", which does not exist in ConfigMap "app-config"
+`,
+		},
+		{
+			name:          "Pod init container failed",
+			workflowPaths: []string{workflow(initContainerFailed)},
+			expectReady:   false,
+			expectMessage: `["pending"] Waiting for Pod "web" to be initialized -- [Pod web]: containers with incomplete status: [migrate][CrashLoopBackOff] back-off 10s restarting failed container=migrate pod=web_default(2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e)
+Init container "migrate" failed (exit code 2)
+error: relation "users" already exists
+`,
+		},
+		{
+			name:          "Pod with a native sidecar",
+			workflowPaths: []string{workflow(sidecar)},
+			expectReady:   true,
+		},
+		{
+			name:          "Pod with a readiness gate",
+			workflowPaths: []string{workflow(readinessGate)},
+			expectReady:   true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			podChecker := NewPodChecker()
-
-			ready := false
-			var details checker.Results
-			podStates := loadWorkflows(t, tt.workflowPaths...)
-			for _, podState := range podStates {
-				ready, details = podChecker.ReadyDetails(podState)
-				if ready {
-					break
-				}
-			}
-			fmt.Println(details)
-			assert.Equal(t, tt.expectReady, ready)
+			podStates := test.LoadWorkflowFixtures[corev1.Pod](t, tt.workflowPaths...)
+			result := test.AssertReplay(t, NewPodChecker(), podStates).Result
+			assert.Equal(t, tt.expectReady, result.Ready())
+			assert.Equal(t, tt.expectFailed, result.Results.Status() == checker.StatusFailed)
 			if tt.expectMessage != "" {
-				assert.Contains(t, details.String(), tt.expectMessage)
+				assert.Contains(t, result.Results.String(), tt.expectMessage)
 			}
 		})
 	}
 }
 
-//
-// Helpers
-//
-
-func loadPod(t *testing.T, statePath string) *corev1.Pod {
-	jsonBytes, err := internal.TestStates.ReadFile(statePath)
+func Test_Untyped_Pod_Checker(t *testing.T) {
+	jsonBytes, err := internal.TestStates.ReadFile("states/kubernetes/pod/ready.json")
 	require.NoError(t, err)
-
 	state := test.MustLoadState(jsonBytes)
-	pod := corev1.Pod{}
-	err = test.BuiltInScheme.Convert(state, &pod, nil)
-	require.NoError(t, err)
 
-	return &pod
-}
+	podChecker := NewUntypedPodChecker()
 
-func loadWorkflows(t *testing.T, workflowPaths ...string) []*corev1.Pod {
-	var pods []*corev1.Pod
-	for _, workflowPath := range workflowPaths {
-		jsonBytes, err := internal.TestStates.ReadFile(workflowPath)
-		require.NoError(t, err)
+	assert.True(t, podChecker.Ready(state))
+	assert.True(t, podChecker.Ready(test.LoadFixture[corev1.Pod](t, "states/kubernetes/pod/ready.json")))
 
-		states := test.MustLoadWorkflow(jsonBytes)
-		for _, state := range states {
-			pod := corev1.Pod{}
-			err = test.BuiltInScheme.Convert(state, &pod, nil)
-			require.NoError(t, err)
-			pods = append(pods, &pod)
-		}
-	}
-
-	return pods
+	ready, result := podChecker.ReadyStatus(&corev1.Service{})
+	assert.False(t, ready)
+	assert.Equal(t, "unexpected state type: got *v1.Service, want *v1.Pod", result.Message.S)
 }
 
+//
+// Helpers
+//
+
 func workflowPath(name string) string {
 	return fmt.Sprintf("workflows/kubernetes/pod/%s.json", name)
 }
+
+func ptr[T any](v T) *T {
+	return &v
+}
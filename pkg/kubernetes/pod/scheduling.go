@@ -0,0 +1,249 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pod
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// SchedulingReason categorizes why the scheduler could not place a Pod on some nodes.
+type SchedulingReason string
+
+const (
+	SchedulingInsufficientResource       SchedulingReason = "InsufficientResource"
+	SchedulingUntoleratedTaint           SchedulingReason = "UntoleratedTaint"
+	SchedulingNodeAffinity               SchedulingReason = "NodeAffinity"
+	SchedulingUnboundPersistentVolume    SchedulingReason = "UnboundPersistentVolumeClaim"
+	SchedulingVolumeNodeAffinityConflict SchedulingReason = "VolumeNodeAffinityConflict"
+	SchedulingTopologySpread             SchedulingReason = "TopologySpread"
+	SchedulingOther                      SchedulingReason = "Other"
+)
+
+// SchedulingFailure is the parsed form of a `FailedScheduling` message, e.g.,
+// `0/3 nodes are available: 1 Insufficient memory, 2 node(s) had untolerated taint {dedicated: gpu}.`
+// It is set as the Data of the Result of the Pod scheduling condition.
+type SchedulingFailure struct {
+	AvailableNodes int                   // The number of nodes that can run the Pod.
+	TotalNodes     int                   // The number of nodes considered by the scheduler.
+	Reasons        []SchedulingCondition // The reasons that nodes can't run the Pod.
+	Summary        []string              // A human-readable explanation of each reason, including the Pod's requests.
+}
+
+// SchedulingCondition is a single reason from a `FailedScheduling` message.
+type SchedulingCondition struct {
+	Reason   SchedulingReason    // The category of the reason.
+	Nodes    int                 // The number of nodes affected, or 0 if the reason is not specific to nodes.
+	Resource corev1.ResourceName // The insufficient resource, for SchedulingInsufficientResource.
+	Taint    string              // The untolerated taint, for SchedulingUntoleratedTaint.
+	Message  string              // The reason as reported by the scheduler.
+}
+
+var (
+	schedulingMsgRegexp = regexp.MustCompile(`^(\d+)/(\d+) nodes are available: (.*)$`)
+	nodeCountRegexp     = regexp.MustCompile(`^(\d+) (.*)$`)
+	taintRegexp         = regexp.MustCompile(`\{[^}]*\}`)
+)
+
+// ParseSchedulingFailure parses the message of an unschedulable Pod's `PodScheduled` condition. The Pod is used to
+// describe its requests, node selector and volumes in the summary, and may be nil. It returns false if the message
+// is not in the scheduler's format.
+func ParseSchedulingFailure(pod *corev1.Pod, msg string) (*SchedulingFailure, bool) {
+	// Ignore the preemption details, which only explain why no other Pods were evicted to make room.
+	msg, _, _ = strings.Cut(msg, " preemption: ")
+	match := schedulingMsgRegexp.FindStringSubmatch(strings.TrimSpace(msg))
+	if match == nil {
+		return nil, false
+	}
+
+	failure := &SchedulingFailure{}
+	failure.AvailableNodes, _ = strconv.Atoi(match[1])
+	failure.TotalNodes, _ = strconv.Atoi(match[2])
+
+	for _, item := range splitSchedulingReasons(strings.TrimSuffix(match[3], ".")) {
+		condition := parseSchedulingCondition(item)
+		failure.Reasons = append(failure.Reasons, condition)
+		failure.Summary = append(failure.Summary, summarizeSchedulingCondition(pod, condition))
+	}
+
+	return failure, true
+}
+
+// splitSchedulingReasons splits the comma-separated reasons of a scheduling message. Older schedulers report taints as
+// `had taint {key: value}, that the pod didn't tolerate`, so a clause starting with "that" belongs to the previous one.
+func splitSchedulingReasons(reasons string) []string {
+	var items []string
+	for _, item := range strings.Split(reasons, ", ") {
+		if len(items) > 0 && strings.HasPrefix(item, "that ") {
+			items[len(items)-1] += ", " + item
+			continue
+		}
+		items = append(items, strings.TrimSpace(item))
+	}
+
+	return items
+}
+
+func parseSchedulingCondition(item string) SchedulingCondition {
+	condition := SchedulingCondition{Reason: SchedulingOther, Message: item}
+
+	text := item
+	if match := nodeCountRegexp.FindStringSubmatch(item); match != nil {
+		condition.Nodes, _ = strconv.Atoi(match[1])
+		text = match[2]
+	}
+
+	switch {
+	case strings.HasPrefix(text, "Insufficient "):
+		condition.Reason = SchedulingInsufficientResource
+		condition.Resource = corev1.ResourceName(strings.TrimPrefix(text, "Insufficient "))
+	case strings.Contains(text, "taint"):
+		condition.Reason = SchedulingUntoleratedTaint
+		condition.Taint = taintRegexp.FindString(text)
+	case strings.Contains(text, "volume node affinity conflict"):
+		condition.Reason = SchedulingVolumeNodeAffinityConflict
+	case strings.Contains(text, "node affinity") || strings.Contains(text, "node selector"):
+		condition.Reason = SchedulingNodeAffinity
+	case strings.Contains(text, "unbound immediate PersistentVolumeClaims"),
+		strings.Contains(text, "didn't find available persistent volumes to bind"):
+		condition.Reason = SchedulingUnboundPersistentVolume
+	case strings.Contains(text, "topology spread constraints"):
+		condition.Reason = SchedulingTopologySpread
+	}
+
+	return condition
+}
+
+func summarizeSchedulingCondition(pod *corev1.Pod, condition SchedulingCondition) string {
+	switch condition.Reason {
+	case SchedulingInsufficientResource:
+		msg := fmt.Sprintf("%d node(s) have insufficient %s", condition.Nodes, condition.Resource)
+		if pod != nil {
+			if request, ok := podRequests(pod)[condition.Resource]; ok {
+				msg += fmt.Sprintf(" for the Pod's request of %s", request.String())
+			}
+		}
+		return msg
+	case SchedulingUntoleratedTaint:
+		return fmt.Sprintf("%d node(s) have the taint %s, which the Pod doesn't tolerate", condition.Nodes, condition.Taint)
+	case SchedulingNodeAffinity:
+		msg := fmt.Sprintf("%d node(s) don't match the Pod's node affinity or nodeSelector", condition.Nodes)
+		if pod != nil && len(pod.Spec.NodeSelector) > 0 {
+			msg += fmt.Sprintf(" (nodeSelector: %s)", formatNodeSelector(pod.Spec.NodeSelector))
+		}
+		return msg
+	case SchedulingUnboundPersistentVolume:
+		claims := "PersistentVolumeClaims"
+		if pod != nil && len(formatClaims(pod)) > 0 {
+			claims += " " + formatClaims(pod)
+		}
+		if condition.Nodes == 0 {
+			return fmt.Sprintf("The Pod's %s are not bound", claims)
+		}
+		return fmt.Sprintf("%d node(s) have no PersistentVolumes available to bind the Pod's %s", condition.Nodes, claims)
+	case SchedulingVolumeNodeAffinityConflict:
+		return fmt.Sprintf("%d node(s) can't access the Pod's PersistentVolumes", condition.Nodes)
+	case SchedulingTopologySpread:
+		return fmt.Sprintf("%d node(s) don't satisfy the Pod's topology spread constraints", condition.Nodes)
+	default:
+		return condition.Message
+	}
+}
+
+// podRequests returns the effective resource requests of the Pod, as computed by the scheduler: the sum of the
+// requests of its containers and native sidecars, or the peak request of its init containers if that is larger. Each
+// init container runs alongside the sidecars that are declared before it, so its peak request includes theirs.
+// Containers that only set a limit request the same amount.
+func podRequests(pod *corev1.Pod) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for _, container := range pod.Spec.Containers {
+		addRequests(requests, containerRequests(container))
+	}
+
+	sidecarRequests := corev1.ResourceList{}
+	initRequests := corev1.ResourceList{}
+	for _, container := range pod.Spec.InitContainers {
+		if kubernetes.IsSidecar(&container) {
+			addRequests(requests, containerRequests(container))
+			addRequests(sidecarRequests, containerRequests(container))
+			maxRequests(initRequests, sidecarRequests)
+			continue
+		}
+
+		peak := containerRequests(container)
+		addRequests(peak, sidecarRequests)
+		maxRequests(initRequests, peak)
+	}
+	maxRequests(requests, initRequests)
+
+	return requests
+}
+
+func addRequests(requests corev1.ResourceList, add corev1.ResourceList) {
+	for name, request := range add {
+		current := requests[name]
+		total := current.DeepCopy()
+		total.Add(request)
+		requests[name] = total
+	}
+}
+
+// maxRequests sets each request to the larger of its current value and the value in other.
+func maxRequests(requests corev1.ResourceList, other corev1.ResourceList) {
+	for name, request := range other {
+		if current, ok := requests[name]; !ok || request.Cmp(current) > 0 {
+			requests[name] = request.DeepCopy()
+		}
+	}
+}
+
+func containerRequests(container corev1.Container) corev1.ResourceList {
+	requests := corev1.ResourceList{}
+	for name, limit := range container.Resources.Limits {
+		requests[name] = limit
+	}
+	for name, request := range container.Resources.Requests {
+		requests[name] = request
+	}
+
+	return requests
+}
+
+func formatNodeSelector(selector map[string]string) string {
+	var terms []string
+	for key, value := range selector {
+		terms = append(terms, fmt.Sprintf("%s=%s", key, value))
+	}
+	sort.Strings(terms)
+
+	return strings.Join(terms, ", ")
+}
+
+func formatClaims(pod *corev1.Pod) string {
+	var claims []string
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			claims = append(claims, fmt.Sprintf("%q", volume.PersistentVolumeClaim.ClaimName))
+		}
+	}
+
+	return strings.Join(claims, ", ")
+}
@@ -0,0 +1,202 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pvc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// selectedNodeAnnotation is set by the scheduler on a claim with delayed binding once a Pod that uses it has been
+// scheduled, which starts provisioning.
+const selectedNodeAnnotation = "volume.kubernetes.io/selected-node"
+
+// StorageClassLookup finds the StorageClass of a PersistentVolumeClaim.
+type StorageClassLookup interface {
+	// LookupStorageClass returns the named StorageClass, or false if the StorageClass does not exist.
+	LookupStorageClass(name string) (*storagev1.StorageClass, bool)
+}
+
+// PVCCheckerArgs configures the checker returned by NewPVCCheckerWithArgs.
+type PVCCheckerArgs struct {
+	// StorageClasses is used to find the volume binding mode of a claim's StorageClass. If set, a Pending claim whose
+	// StorageClass has `volumeBindingMode: WaitForFirstConsumer` is ready, since it won't be bound until a Pod that
+	// uses it is scheduled.
+	StorageClasses StorageClassLookup
+}
+
+// NewPVCChecker returns a checker that waits for a PersistentVolumeClaim to be bound and for any requested expansion
+// to finish. A claim that lost its PersistentVolume, or whose expansion is infeasible, is reported as failed.
+func NewPVCChecker() *checker.StateChecker[*corev1.PersistentVolumeClaim] {
+	return NewPVCCheckerWithArgs(nil)
+}
+
+// NewPVCCheckerWithArgs returns a pvc checker configured with the provided args.
+func NewPVCCheckerWithArgs(args *PVCCheckerArgs) *checker.StateChecker[*corev1.PersistentVolumeClaim] {
+	var lookup StorageClassLookup
+	if args != nil {
+		lookup = args.StorageClasses
+	}
+
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*corev1.PersistentVolumeClaim]{
+		Conditions: []checker.Condition[*corev1.PersistentVolumeClaim]{
+			func(pvc *corev1.PersistentVolumeClaim) checker.Result { return pvcBound(pvc, lookup) },
+			pvcResized,
+		},
+	})
+}
+
+// NewUntypedPVCChecker returns a pvc checker for callers that pass states as interface{}. States may be either
+// *corev1.PersistentVolumeClaim or *unstructured.Unstructured.
+func NewUntypedPVCChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewPVCChecker(), kubernetes.FromUnstructured[corev1.PersistentVolumeClaim])
+}
+
+//
+// Conditions
+//
+
+func pvcBound(pvc *corev1.PersistentVolumeClaim, lookup StorageClassLookup) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for PersistentVolumeClaim %q to be bound", kubernetes.FullyQualifiedName(pvc))}
+
+	switch pvc.Status.Phase {
+	case corev1.ClaimBound:
+		result.Ok = true
+	case corev1.ClaimLost:
+		result.Failed = true
+		result.Message = logging.ErrorMessage(fmt.Sprintf(
+			"Claim lost its PersistentVolume %q, which no longer exists", pvc.Spec.VolumeName))
+	default:
+		if waitsForFirstConsumer(pvc, lookup) {
+			result.Ok = true
+			result.Message = logging.StatusMessage(fmt.Sprintf(
+				"StorageClass %q has volumeBindingMode %s; the claim will be bound when a Pod that uses it is scheduled",
+				*pvc.Spec.StorageClassName, storagev1.VolumeBindingWaitForFirstConsumer))
+			return result
+		}
+		result.Message = logging.StatusMessage(pendingMessage(pvc))
+	}
+
+	return result
+}
+
+func pvcResized(pvc *corev1.PersistentVolumeClaim) checker.Result {
+	requested := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+	capacity := pvc.Status.Capacity[corev1.ResourceStorage]
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for PersistentVolumeClaim %q to be resized (Requested: %s | Capacity: %s)",
+		kubernetes.FullyQualifiedName(pvc), requested.String(), capacity.String())}
+
+	// Only a bound claim can be expanded.
+	if pvc.Status.Phase != corev1.ClaimBound {
+		result.Ok = true
+		return result
+	}
+
+	errorConditions := conditionMessages(pvc,
+		corev1.PersistentVolumeClaimControllerResizeError, corev1.PersistentVolumeClaimNodeResizeError)
+	if status, infeasible := resizeInfeasible(pvc); infeasible {
+		result.Failed = true
+		result.Message = logging.ErrorMessage(strings.Join(
+			append([]string{fmt.Sprintf("Expansion is infeasible (%s)", status)}, errorConditions...), "\n"))
+		return result
+	}
+	if len(errorConditions) > 0 {
+		// Resize errors are retried, so they are not reported as a failure unless the expansion is infeasible.
+		result.Message = logging.WarningMessage(strings.Join(errorConditions, "\n"))
+		return result
+	}
+
+	if progress := conditionMessages(pvc,
+		corev1.PersistentVolumeClaimResizing, corev1.PersistentVolumeClaimFileSystemResizePending); len(progress) > 0 {
+		result.Message = logging.StatusMessage(strings.Join(progress, "\n"))
+		return result
+	}
+
+	if requested.Cmp(capacity) <= 0 {
+		result.Ok = true
+	}
+
+	return result
+}
+
+//
+// Helpers
+//
+
+// waitsForFirstConsumer returns true if the claim's StorageClass delays binding until a Pod that uses the claim is
+// scheduled, and no such Pod has been scheduled yet.
+func waitsForFirstConsumer(pvc *corev1.PersistentVolumeClaim, lookup StorageClassLookup) bool {
+	if lookup == nil || pvc.Spec.StorageClassName == nil || len(pvc.Spec.VolumeName) > 0 {
+		return false
+	}
+	if _, selected := pvc.Annotations[selectedNodeAnnotation]; selected {
+		return false
+	}
+
+	storageClass, found := lookup.LookupStorageClass(*pvc.Spec.StorageClassName)
+	if !found || storageClass == nil || storageClass.VolumeBindingMode == nil {
+		return false
+	}
+
+	return *storageClass.VolumeBindingMode == storagev1.VolumeBindingWaitForFirstConsumer
+}
+
+func pendingMessage(pvc *corev1.PersistentVolumeClaim) string {
+	switch {
+	case len(pvc.Spec.VolumeName) > 0:
+		return fmt.Sprintf("Waiting for PersistentVolume %q", pvc.Spec.VolumeName)
+	case pvc.Spec.StorageClassName != nil && len(*pvc.Spec.StorageClassName) > 0:
+		return fmt.Sprintf("Waiting for a PersistentVolume to be provisioned for StorageClass %q", *pvc.Spec.StorageClassName)
+	default:
+		return "Waiting for a matching PersistentVolume"
+	}
+}
+
+// resizeInfeasible returns the allocated resource status of the claim's storage if it reports that the volume can't be
+// expanded to the requested size.
+func resizeInfeasible(pvc *corev1.PersistentVolumeClaim) (corev1.ClaimResourceStatus, bool) {
+	status := pvc.Status.AllocatedResourceStatuses[corev1.ResourceStorage]
+	switch status {
+	case corev1.PersistentVolumeClaimControllerResizeInfeasible, corev1.PersistentVolumeClaimNodeResizeInfeasible:
+		return status, true
+	default:
+		return "", false
+	}
+}
+
+// conditionMessages returns the true conditions of the provided types, formatted as "[Type] message".
+func conditionMessages(
+	pvc *corev1.PersistentVolumeClaim, conditionTypes ...corev1.PersistentVolumeClaimConditionType,
+) []string {
+	var messages []string
+	for _, conditionType := range conditionTypes {
+		for _, condition := range pvc.Status.Conditions {
+			if condition.Type != conditionType || condition.Status != corev1.ConditionTrue {
+				continue
+			}
+			messages = append(messages, kubernetes.ConditionMessage(string(condition.Type), condition.Message))
+		}
+	}
+
+	return messages
+}
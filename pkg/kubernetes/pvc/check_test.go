@@ -0,0 +1,238 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pvc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+)
+
+// fakeLookup is a StorageClassLookup backed by a map of StorageClass names to StorageClasses.
+type fakeLookup map[string]*storagev1.StorageClass
+
+func (l fakeLookup) LookupStorageClass(name string) (*storagev1.StorageClass, bool) {
+	storageClass, ok := l[name]
+	return storageClass, ok
+}
+
+//
+// Test Conditions
+//
+
+func Test_pvcBound(t *testing.T) {
+	lookup := fakeLookup{
+		"standard": test.LoadFixture[storagev1.StorageClass](t, "states/kubernetes/storageclass/standard.json"),
+		"gp3":      test.LoadFixture[storagev1.StorageClass](t, "states/kubernetes/storageclass/gp3.json"),
+	}
+
+	tests := []struct {
+		name          string
+		testStatePath string
+		lookup        StorageClassLookup
+		want          checker.Status
+		wantMessage   string
+	}{
+		{
+			name:          "Pending",
+			testStatePath: "states/kubernetes/pvc/pending.json",
+			lookup:        lookup,
+			want:          checker.StatusPending,
+			wantMessage:   `Waiting for a PersistentVolume to be provisioned for StorageClass "standard"`,
+		},
+		{
+			name:          "Bound",
+			testStatePath: "states/kubernetes/pvc/bound.json",
+			want:          checker.StatusReady,
+		},
+		{
+			name:          "Lost",
+			testStatePath: "states/kubernetes/pvc/lost.json",
+			want:          checker.StatusFailed,
+			wantMessage: `Claim lost its PersistentVolume "pvc-2c3d4e5f-6a7b-4c8d-9e0f-1a2b3c4d5e6f", ` +
+				`which no longer exists`,
+		},
+		{
+			name:          "Pending with WaitForFirstConsumer",
+			testStatePath: "states/kubernetes/pvc/pendingWaitForFirstConsumer.json",
+			lookup:        lookup,
+			want:          checker.StatusReady,
+			wantMessage: `StorageClass "gp3" has volumeBindingMode WaitForFirstConsumer; ` +
+				`the claim will be bound when a Pod that uses it is scheduled`,
+		},
+		{
+			name:          "Pending with WaitForFirstConsumer without lookup",
+			testStatePath: "states/kubernetes/pvc/pendingWaitForFirstConsumer.json",
+			want:          checker.StatusPending,
+			wantMessage:   `Waiting for a PersistentVolume to be provisioned for StorageClass "gp3"`,
+		},
+		{
+			name:          "Pending with unknown StorageClass",
+			testStatePath: "states/kubernetes/pvc/pendingWaitForFirstConsumer.json",
+			lookup:        fakeLookup{},
+			want:          checker.StatusPending,
+			wantMessage:   `Waiting for a PersistentVolume to be provisioned for StorageClass "gp3"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvc := test.LoadFixture[corev1.PersistentVolumeClaim](t, tt.testStatePath)
+			got := pvcBound(pvc, tt.lookup)
+			assert.Equal(t, tt.want, checker.Results{got}.Status())
+			assert.Equal(t, tt.wantMessage, got.Message.S)
+		})
+	}
+}
+
+func Test_pvcBound_SelectedNode(t *testing.T) {
+	lookup := fakeLookup{"gp3": test.LoadFixture[storagev1.StorageClass](t, "states/kubernetes/storageclass/gp3.json")}
+	pvc := test.LoadFixture[corev1.PersistentVolumeClaim](t, "states/kubernetes/pvc/pendingWaitForFirstConsumer.json")
+	pvc.Annotations = map[string]string{selectedNodeAnnotation: "ip-10-0-1-17.ec2.internal"}
+
+	got := pvcBound(pvc, lookup)
+	assert.False(t, got.Ok)
+}
+
+func Test_pvcResized(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          checker.Status
+		wantMessage   string
+	}{
+		{
+			name:          "Bound",
+			testStatePath: "states/kubernetes/pvc/bound.json",
+			want:          checker.StatusReady,
+		},
+		{
+			name:          "Pending",
+			testStatePath: "states/kubernetes/pvc/pending.json",
+			want:          checker.StatusReady,
+		},
+		{
+			name:          "Resizing",
+			testStatePath: "states/kubernetes/pvc/resizing.json",
+			want:          checker.StatusPending,
+			wantMessage:   "[Resizing]",
+		},
+		{
+			name:          "File system resize pending",
+			testStatePath: "states/kubernetes/pvc/fileSystemResizePending.json",
+			want:          checker.StatusPending,
+			wantMessage: "[FileSystemResizePending] Waiting for user to (re-)start a pod to finish file system resize " +
+				"of volume on node.",
+		},
+		{
+			name:          "Resized",
+			testStatePath: "states/kubernetes/pvc/resized.json",
+			want:          checker.StatusReady,
+		},
+		{
+			name:          "Resize infeasible",
+			testStatePath: "states/kubernetes/pvc/resizeInfeasible.json",
+			want:          checker.StatusFailed,
+			wantMessage: `Expansion is infeasible (ControllerResizeInfeasible)
+[ControllerResizeError] resize volume "pvc-2c3d4e5f-6a7b-4c8d-9e0f-1a2b3c4d5e6f" by resizer "ebs.csi.aws.com" ` +
+				`failed: rpc error: code = OutOfRange desc = new size 20Gi exceeds the maximum size of the volume type`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvc := test.LoadFixture[corev1.PersistentVolumeClaim](t, tt.testStatePath)
+			got := pvcResized(pvc)
+			assert.Equal(t, tt.want, checker.Results{got}.Status())
+			assert.Equal(t, tt.wantMessage, got.Message.S)
+		})
+	}
+}
+
+//
+// Test PersistentVolumeClaim State Checker using recorded events.
+//
+
+func Test_PVC_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		bound                = "bound"
+		resized              = "resized"
+		resizeInfeasible     = "resizeInfeasible"
+		waitForFirstConsumer = "waitForFirstConsumer"
+	)
+
+	lookup := fakeLookup{"gp3": test.LoadFixture[storagev1.StorageClass](t, "states/kubernetes/storageclass/gp3.json")}
+
+	tests := []struct {
+		name         string
+		workflowPath string
+		args         *PVCCheckerArgs
+		expectReady  bool
+		expectFailed bool
+	}{
+		{name: "Claim bound", workflowPath: workflow(bound), expectReady: true},
+		{name: "Claim resized", workflowPath: workflow(resized), expectReady: true},
+		{name: "Claim resize infeasible", workflowPath: workflow(resizeInfeasible), expectFailed: true},
+		{name: "Claim waiting for first consumer", workflowPath: workflow(waitForFirstConsumer)},
+		{
+			name:         "Claim waiting for first consumer with opt-in",
+			workflowPath: workflow(waitForFirstConsumer),
+			args:         &PVCCheckerArgs{StorageClasses: lookup},
+			expectReady:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pvcs := test.LoadWorkflowFixtures[corev1.PersistentVolumeClaim](t, tt.workflowPath)
+
+			result := checker.Await(context.Background(), NewPVCCheckerWithArgs(tt.args), test.Stream(pvcs))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			assert.Equal(t, tt.expectFailed, result.Results.Status() == checker.StatusFailed)
+		})
+	}
+}
+
+func Test_PVC_Checker_Progress(t *testing.T) {
+	pvcs := test.LoadWorkflowFixtures[corev1.PersistentVolumeClaim](t, workflowPath("resized"))
+
+	var progress []string
+	result := checker.Await(context.Background(), NewPVCChecker(), test.Stream(pvcs), func(results checker.Results) {
+		progress = append(progress, results[len(results)-1].String())
+	})
+	assert.True(t, result.Ready())
+	assert.Equal(t, []string{
+		`["pending"] Waiting for PersistentVolumeClaim "postgres-data" to be resized (Requested: 20Gi | Capacity: 10Gi)`,
+		`["pending"] Waiting for PersistentVolumeClaim "postgres-data" to be resized (Requested: 20Gi | Capacity: 10Gi) -- ` +
+			`[Resizing]`,
+		`["pending"] Waiting for PersistentVolumeClaim "postgres-data" to be resized (Requested: 20Gi | Capacity: 10Gi) -- ` +
+			`[FileSystemResizePending] Waiting for user to (re-)start a pod to finish file system resize of volume on node.`,
+		`["done"] Waiting for PersistentVolumeClaim "postgres-data" to be resized (Requested: 20Gi | Capacity: 20Gi)`,
+	}, progress)
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/pvc/%s.json", name)
+}
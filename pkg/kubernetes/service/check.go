@@ -0,0 +1,138 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+// State is a Service together with the EndpointSlices that back it.
+type State struct {
+	Service *corev1.Service
+	// EndpointSlices for the Service. Slices that are not labelled with the Service's name are ignored.
+	EndpointSlices []*discoveryv1.EndpointSlice
+}
+
+// NewServiceChecker returns a checker that waits for LoadBalancer Services to be assigned an ingress. Other types of
+// Service are ready immediately.
+func NewServiceChecker() *checker.StateChecker[*corev1.Service] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*corev1.Service]{
+		Conditions: []checker.Condition[*corev1.Service]{serviceLoadBalancerIngress},
+	})
+}
+
+// NewUntypedServiceChecker returns a service checker for callers that pass states as interface{}. States may be
+// either *corev1.Service or *unstructured.Unstructured.
+func NewUntypedServiceChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewServiceChecker(), kubernetes.FromUnstructured[corev1.Service])
+}
+
+// NewServiceWithEndpointsChecker returns a checker that additionally waits for a Service with a selector to have at
+// least one ready endpoint. Headless and ExternalName Services are ready immediately.
+func NewServiceWithEndpointsChecker() *checker.StateChecker[*State] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*State]{
+		Conditions: []checker.Condition[*State]{
+			func(state *State) checker.Result { return serviceLoadBalancerIngress(state.Service) },
+			serviceEndpointsReady,
+		},
+	})
+}
+
+//
+// Conditions
+//
+
+func serviceLoadBalancerIngress(svc *corev1.Service) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Service %q to be assigned a load balancer ingress", kubernetes.FullyQualifiedName(svc))}
+
+	if svc.Spec.Type != corev1.ServiceTypeLoadBalancer || len(svc.Status.LoadBalancer.Ingress) > 0 {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func serviceEndpointsReady(state *State) checker.Result {
+	svc := state.Service
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for Service %q to target at least one ready Pod", kubernetes.FullyQualifiedName(svc))}
+
+	if EndpointsReady(state) {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(fmt.Sprintf(
+		"Service %q does not have any ready endpoints; check that its selector matches ready Pods",
+		kubernetes.FullyQualifiedName(svc)))
+	return result
+}
+
+//
+// Helpers
+//
+
+// EndpointsReady returns true if the Service does not need endpoints to receive traffic (e.g., it is headless or an
+// ExternalName Service), or if its EndpointSlices contain at least one ready endpoint.
+func EndpointsReady(state *State) bool {
+	if !targetsPods(state.Service) {
+		return true
+	}
+
+	var slices []*discoveryv1.EndpointSlice
+	for _, slice := range state.EndpointSlices {
+		if slice.Labels[discoveryv1.LabelServiceName] == state.Service.Name {
+			slices = append(slices, slice)
+		}
+	}
+
+	return HasReadyEndpoints(slices)
+}
+
+// HasReadyEndpoints returns true if any of the EndpointSlices contains a ready endpoint.
+func HasReadyEndpoints(slices []*discoveryv1.EndpointSlice) bool {
+	for _, slice := range slices {
+		for _, endpoint := range slice.Endpoints {
+			// A nil ready condition means the state is unknown, which consumers should interpret as ready.
+			if endpoint.Conditions.Ready == nil || *endpoint.Conditions.Ready {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// targetsPods returns true if the Service's endpoints are managed by the EndpointSlice controller and expected to
+// point at ready Pods.
+func targetsPods(svc *corev1.Service) bool {
+	switch {
+	case svc.Spec.Type == corev1.ServiceTypeExternalName:
+		return false
+	case svc.Spec.ClusterIP == corev1.ClusterIPNone:
+		return false
+	case len(svc.Spec.Selector) == 0:
+		return false
+	default:
+		return true
+	}
+}
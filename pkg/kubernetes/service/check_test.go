@@ -0,0 +1,195 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_serviceLoadBalancerIngress(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		want          bool
+	}{
+		{
+			"LoadBalancer Service pending",
+			"states/kubernetes/service/loadBalancerPending.json",
+			false,
+		},
+		{
+			"LoadBalancer Service ready",
+			"states/kubernetes/service/loadBalancerReady.json",
+			true,
+		},
+		{
+			"ClusterIP Service",
+			"states/kubernetes/service/clusterIP.json",
+			true,
+		},
+		{
+			"ExternalName Service",
+			"states/kubernetes/service/externalName.json",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := test.LoadFixture[corev1.Service](t, tt.testStatePath)
+			if got := serviceLoadBalancerIngress(svc); got.Ok != tt.want {
+				t.Errorf("serviceLoadBalancerIngress() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_serviceEndpointsReady(t *testing.T) {
+	tests := []struct {
+		name               string
+		testStatePath      string
+		endpointSlicePaths []string
+		want               bool
+	}{
+		{
+			"Service with ready endpoints",
+			"states/kubernetes/service/clusterIP.json",
+			[]string{"states/kubernetes/endpointslice/unready.json", "states/kubernetes/endpointslice/ready.json"},
+			true,
+		},
+		{
+			"Service with unready endpoints",
+			"states/kubernetes/service/clusterIP.json",
+			[]string{"states/kubernetes/endpointslice/unready.json"},
+			false,
+		},
+		{
+			"Service with empty endpoint slice",
+			"states/kubernetes/service/clusterIP.json",
+			[]string{"states/kubernetes/endpointslice/empty.json"},
+			false,
+		},
+		{
+			"Service without endpoint slices",
+			"states/kubernetes/service/clusterIP.json",
+			nil,
+			false,
+		},
+		{
+			"Service with endpoint slices for another Service",
+			"states/kubernetes/service/loadBalancerReady.json",
+			[]string{"states/kubernetes/endpointslice/ready.json"},
+			false,
+		},
+		{
+			"Headless Service",
+			"states/kubernetes/service/headless.json",
+			nil,
+			true,
+		},
+		{
+			"ExternalName Service",
+			"states/kubernetes/service/externalName.json",
+			nil,
+			true,
+		},
+		{
+			"Service without selector",
+			"states/kubernetes/service/withoutSelector.json",
+			nil,
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &State{Service: test.LoadFixture[corev1.Service](t, tt.testStatePath)}
+			for _, path := range tt.endpointSlicePaths {
+				state.EndpointSlices = append(state.EndpointSlices, test.LoadFixture[discoveryv1.EndpointSlice](t, path))
+			}
+			if got := serviceEndpointsReady(state); got.Ok != tt.want {
+				t.Errorf("serviceEndpointsReady() = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+func Test_HasReadyEndpoints(t *testing.T) {
+	ready := test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/ready.json")
+	unready := test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/unready.json")
+	empty := test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/empty.json")
+
+	assert.True(t, HasReadyEndpoints([]*discoveryv1.EndpointSlice{unready, ready}))
+	assert.False(t, HasReadyEndpoints([]*discoveryv1.EndpointSlice{unready, empty}))
+	assert.False(t, HasReadyEndpoints(nil))
+}
+
+//
+// Test Service State Checker using recorded events.
+//
+
+func Test_Service_Checker(t *testing.T) {
+	services := test.LoadWorkflowFixtures[corev1.Service](t, workflowPath("loadBalancer"))
+
+	result := checker.Await(context.Background(), NewServiceChecker(), test.Stream(services[:1]))
+	assert.False(t, result.Ready())
+	assert.Equal(t, `["pending"] Waiting for Service "frontend" to be assigned a load balancer ingress
+`, result.Results.String())
+
+	result = checker.Await(context.Background(), NewServiceChecker(), test.Stream(services))
+	assert.True(t, result.Ready())
+}
+
+func Test_Service_With_Endpoints_Checker(t *testing.T) {
+	svc := test.LoadFixture[corev1.Service](t, "states/kubernetes/service/clusterIP.json")
+	states := []*State{
+		{Service: svc},
+		{Service: svc, EndpointSlices: []*discoveryv1.EndpointSlice{
+			test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/unready.json"),
+		}},
+		{Service: svc, EndpointSlices: []*discoveryv1.EndpointSlice{
+			test.LoadFixture[discoveryv1.EndpointSlice](t, "states/kubernetes/endpointslice/ready.json"),
+		}},
+	}
+
+	serviceChecker := NewServiceWithEndpointsChecker()
+	_, result := serviceChecker.ReadyStatus(states[1])
+	assert.Equal(t, `["pending"] Waiting for Service "web" to target at least one ready Pod -- `+
+		`Service "web" does not have any ready endpoints; check that its selector matches ready Pods`, result.String())
+
+	awaited := checker.Await(context.Background(), serviceChecker, test.Stream(states))
+	assert.True(t, awaited.Ready())
+	assert.Equal(t, `["done"] Waiting for Service "web" to be assigned a load balancer ingress
+["done"] Waiting for Service "web" to target at least one ready Pod
+`, awaited.Results.String())
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/service/%s.json", name)
+}
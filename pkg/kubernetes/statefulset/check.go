@@ -0,0 +1,148 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+func NewStatefulSetChecker() *checker.StateChecker[*appsv1.StatefulSet] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[*appsv1.StatefulSet]{
+		Conditions: []checker.Condition[*appsv1.StatefulSet]{
+			statefulSetObserved,
+			statefulSetReplicasReady,
+			statefulSetReplicasAvailable,
+			statefulSetUpdated,
+		},
+	})
+}
+
+// NewUntypedStatefulSetChecker returns a statefulset checker for callers that pass states as interface{}. States may
+// be either *appsv1.StatefulSet or *unstructured.Unstructured.
+func NewUntypedStatefulSetChecker() *checker.StateChecker[interface{}] {
+	return checker.Untyped(NewStatefulSetChecker(), kubernetes.FromUnstructured[appsv1.StatefulSet])
+}
+
+//
+// Conditions
+//
+
+func statefulSetObserved(sts *appsv1.StatefulSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for StatefulSet %q to be observed by the controller", kubernetes.FullyQualifiedName(sts))}
+
+	if sts.Status.ObservedGeneration >= sts.Generation {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func statefulSetReplicasReady(sts *appsv1.StatefulSet) checker.Result {
+	desired := desiredReplicas(sts)
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for StatefulSet %q replicas to be ready (Ready: %d/%d)",
+		kubernetes.FullyQualifiedName(sts), sts.Status.ReadyReplicas, desired)}
+
+	if sts.Status.ReadyReplicas >= desired {
+		result.Ok = true
+	}
+
+	return result
+}
+
+func statefulSetReplicasAvailable(sts *appsv1.StatefulSet) checker.Result {
+	desired := desiredReplicas(sts)
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for StatefulSet %q replicas to become available (Available: %d/%d)",
+		kubernetes.FullyQualifiedName(sts), sts.Status.AvailableReplicas, desired)}
+
+	if sts.Status.AvailableReplicas >= desired {
+		result.Ok = true
+		return result
+	}
+
+	// Ready replicas only count as available once they have been ready for minReadySeconds.
+	if sts.Spec.MinReadySeconds > 0 {
+		result.Message = logging.StatusMessage(fmt.Sprintf(
+			"Replicas must be ready for %ds before they are available", sts.Spec.MinReadySeconds))
+	}
+	return result
+}
+
+func statefulSetUpdated(sts *appsv1.StatefulSet) checker.Result {
+	result := checker.Result{Description: fmt.Sprintf(
+		"Waiting for StatefulSet %q to roll out revision %q",
+		kubernetes.FullyQualifiedName(sts), sts.Status.UpdateRevision)}
+
+	switch sts.Spec.UpdateStrategy.Type {
+	case appsv1.OnDeleteStatefulSetStrategyType:
+		// Pods are only updated when they are deleted, so the controller never converges on its own.
+		result.Ok = true
+		if sts.Status.UpdatedReplicas < desiredReplicas(sts) {
+			result.Message = logging.StatusMessage(fmt.Sprintf(
+				"%d/%d replicas are updated; delete the remaining Pods to update them with the OnDelete strategy",
+				sts.Status.UpdatedReplicas, desiredReplicas(sts)))
+		}
+		return result
+	}
+
+	if partition := partition(sts); partition > 0 {
+		expected := max(desiredReplicas(sts)-partition, 0)
+		if sts.Status.UpdatedReplicas >= expected {
+			result.Ok = true
+			return result
+		}
+
+		result.Message = logging.StatusMessage(fmt.Sprintf(
+			"Waiting for partitioned rollout to finish: %d out of %d new Pods have been updated",
+			sts.Status.UpdatedReplicas, expected))
+		return result
+	}
+
+	if sts.Status.UpdateRevision == sts.Status.CurrentRevision {
+		result.Ok = true
+		return result
+	}
+
+	result.Message = logging.StatusMessage(fmt.Sprintf(
+		"%d out of %d Pods are at revision %q", sts.Status.UpdatedReplicas, desiredReplicas(sts),
+		sts.Status.UpdateRevision))
+	return result
+}
+
+//
+// Helpers
+//
+
+func desiredReplicas(sts *appsv1.StatefulSet) int32 {
+	if sts.Spec.Replicas == nil {
+		return 1
+	}
+	return *sts.Spec.Replicas
+}
+
+func partition(sts *appsv1.StatefulSet) int32 {
+	rollingUpdate := sts.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.Partition == nil {
+		return 0
+	}
+	return *rollingUpdate.Partition
+}
@@ -0,0 +1,240 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statefulset
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+)
+
+//
+// Test Conditions
+//
+
+func Test_statefulSetConditions(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     checker.Condition[*appsv1.StatefulSet]
+		testStatePath string
+		want          bool
+	}{
+		{
+			"StatefulSet observed",
+			statefulSetObserved,
+			"states/kubernetes/statefulset/ready.json",
+			true,
+		},
+		{
+			"StatefulSet unobserved",
+			statefulSetObserved,
+			"states/kubernetes/statefulset/unobserved.json",
+			false,
+		},
+		{
+			"StatefulSet replicas ready",
+			statefulSetReplicasReady,
+			"states/kubernetes/statefulset/ready.json",
+			true,
+		},
+		{
+			"StatefulSet replicas unready",
+			statefulSetReplicasReady,
+			"states/kubernetes/statefulset/replicasUnready.json",
+			false,
+		},
+		{
+			"StatefulSet replicas ready but not available for minReadySeconds",
+			statefulSetReplicasAvailable,
+			"states/kubernetes/statefulset/replicasUnavailable.json",
+			false,
+		},
+		{
+			"StatefulSet updated",
+			statefulSetUpdated,
+			"states/kubernetes/statefulset/ready.json",
+			true,
+		},
+		{
+			"StatefulSet update revision pending",
+			statefulSetUpdated,
+			"states/kubernetes/statefulset/revisionPending.json",
+			false,
+		},
+		{
+			"StatefulSet partitioned rollout finished",
+			statefulSetUpdated,
+			"states/kubernetes/statefulset/partitioned.json",
+			true,
+		},
+		{
+			"StatefulSet OnDelete strategy",
+			statefulSetUpdated,
+			"states/kubernetes/statefulset/onDelete.json",
+			true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sts := test.LoadFixture[appsv1.StatefulSet](t, tt.testStatePath)
+			if got := tt.condition(sts); got.Ok != tt.want {
+				t.Errorf("Ok = %v, want %v", got.Ok, tt.want)
+			}
+		})
+	}
+}
+
+//
+// Test StatefulSet State Checker using recorded events.
+//
+
+func Test_StatefulSet_Checker(t *testing.T) {
+	workflow := func(name string) string {
+		return workflowPath(name)
+	}
+	const (
+		added                    = "added"
+		createSuccess            = "createSuccess"
+		minReadySeconds          = "minReadySeconds"
+		onDelete                 = "onDelete"
+		partitionedRollingUpdate = "partitionedRollingUpdate"
+		rollingUpdate            = "rollingUpdate"
+	)
+
+	tests := []struct {
+		name          string
+		workflowPaths []string
+		expectReady   bool
+		expectMessage string
+	}{
+		{
+			name:          "StatefulSet added but not observed",
+			workflowPaths: []string{workflow(added)},
+			expectReady:   false,
+			expectMessage: `["pending"] Waiting for StatefulSet "db" to be observed by the controller
+`,
+		},
+		{
+			name:          "StatefulSet create success",
+			workflowPaths: []string{workflow(createSuccess)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for StatefulSet "db" to be observed by the controller
+["done"] Waiting for StatefulSet "db" replicas to be ready (Ready: 3/3)
+["done"] Waiting for StatefulSet "db" replicas to become available (Available: 3/3)
+["done"] Waiting for StatefulSet "db" to roll out revision "db-5f8b9c7d4d"
+`,
+		},
+		{
+			name:          "StatefulSet rolling update success",
+			workflowPaths: []string{workflow(rollingUpdate)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c"
+`,
+		},
+		{
+			name:          "StatefulSet partitioned rolling update success",
+			workflowPaths: []string{workflow(partitionedRollingUpdate)},
+			expectReady:   true,
+		},
+		{
+			name:          "StatefulSet OnDelete update",
+			workflowPaths: []string{workflow(onDelete)},
+			expectReady:   true,
+			expectMessage: `["done"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c" -- 0/3 replicas are updated; delete the remaining Pods to update them with the OnDelete strategy
+`,
+		},
+		{
+			name:          "StatefulSet with minReadySeconds",
+			workflowPaths: []string{workflow(minReadySeconds)},
+			expectReady:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stsStates := test.LoadWorkflowFixtures[appsv1.StatefulSet](t, tt.workflowPaths...)
+			result := checker.Await(context.Background(), NewStatefulSetChecker(), test.Stream(stsStates))
+			assert.Equal(t, tt.expectReady, result.Ready())
+			if tt.expectMessage != "" {
+				assert.Contains(t, result.Results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_StatefulSet_Checker_Progress(t *testing.T) {
+	tests := []struct {
+		name           string
+		workflowPath   string
+		expectStatuses []string
+	}{
+		{
+			name:         "Rolling update",
+			workflowPath: workflowPath("rollingUpdate"),
+			expectStatuses: []string{
+				`["pending"] Waiting for StatefulSet "db" to be observed by the controller`,
+				`["pending"] Waiting for StatefulSet "db" replicas to be ready (Ready: 2/3)`,
+				`["pending"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c" -- 1 out of 3 Pods are at revision "db-6c9d8f7b5c"`,
+				`["pending"] Waiting for StatefulSet "db" replicas to be ready (Ready: 2/3)`,
+				`["pending"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c" -- 3 out of 3 Pods are at revision "db-6c9d8f7b5c"`,
+				`["done"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c"`,
+			},
+		},
+		{
+			name:         "Partitioned rolling update",
+			workflowPath: workflowPath("partitionedRollingUpdate"),
+			expectStatuses: []string{
+				`["pending"] Waiting for StatefulSet "db" to be observed by the controller`,
+				`["pending"] Waiting for StatefulSet "db" replicas to be ready (Ready: 2/3)`,
+				`["done"] Waiting for StatefulSet "db" to roll out revision "db-6c9d8f7b5c"`,
+			},
+		},
+		{
+			name:         "minReadySeconds",
+			workflowPath: workflowPath("minReadySeconds"),
+			expectStatuses: []string{
+				`["pending"] Waiting for StatefulSet "db" to be observed by the controller`,
+				`["pending"] Waiting for StatefulSet "db" replicas to be ready (Ready: 1/3)`,
+				`["pending"] Waiting for StatefulSet "db" replicas to be ready (Ready: 2/3)`,
+				`["pending"] Waiting for StatefulSet "db" replicas to become available (Available: 2/3) -- Replicas must be ready for 30s before they are available`,
+				`["done"] Waiting for StatefulSet "db" to roll out revision "db-5f8b9c7d4d"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stsChecker := NewStatefulSetChecker()
+
+			var statuses []string
+			for _, sts := range test.LoadWorkflowFixtures[appsv1.StatefulSet](t, tt.workflowPath) {
+				_, result := stsChecker.ReadyStatus(sts)
+				statuses = append(statuses, result.String())
+			}
+			assert.Equal(t, tt.expectStatuses, statuses)
+		})
+	}
+}
+
+//
+// Helpers
+//
+
+func workflowPath(name string) string {
+	return fmt.Sprintf("workflows/kubernetes/statefulset/%s.json", name)
+}
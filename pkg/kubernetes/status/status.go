@@ -0,0 +1,175 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package status computes kstatus-compatible statuses for Kubernetes objects from the results of the state checkers
+// in this module.
+package status
+
+import (
+	"fmt"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/apiservice"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/crd"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/cronjob"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/daemonset"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/deployment"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/generic"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/ingress"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/job"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/pod"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/pvc"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/service"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/statefulset"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Status is a kstatus status.
+type Status string
+
+const (
+	InProgressStatus  Status = "InProgress"
+	FailedStatus      Status = "Failed"
+	CurrentStatus     Status = "Current"
+	TerminatingStatus Status = "Terminating"
+	NotFoundStatus    Status = "NotFound"
+	UnknownStatus     Status = "Unknown"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// Result is the status computed for an object.
+type Result struct {
+	Status  Status          // The kstatus status of the object.
+	Message string          // A human-readable description of the status.
+	Results checker.Results // The checker Results the status was computed from, if any.
+}
+
+// computeFunc evaluates an object with the checker registered for its kind.
+type computeFunc func(obj *unstructured.Unstructured) (checker.Results, error)
+
+var computeFuncs = map[schema.GroupKind]computeFunc{
+	{Group: "", Kind: "Pod"}:                                          typed(pod.NewPodChecker()),
+	{Group: "", Kind: "PersistentVolumeClaim"}:                        typed(pvc.NewPVCChecker()),
+	{Group: "", Kind: "Service"}:                                      typed(service.NewServiceChecker()),
+	{Group: "apps", Kind: "DaemonSet"}:                                typed(daemonset.NewDaemonSetChecker()),
+	{Group: "apps", Kind: "Deployment"}:                               typed(deployment.NewDeploymentChecker()),
+	{Group: "apps", Kind: "StatefulSet"}:                              typed(statefulset.NewStatefulSetChecker()),
+	{Group: "batch", Kind: "CronJob"}:                                 typed(cronjob.NewCronJobChecker()),
+	{Group: "batch", Kind: "Job"}:                                     typed(job.NewJobChecker()),
+	{Group: "networking.k8s.io", Kind: "Ingress"}:                     typed(ingress.NewIngressChecker()),
+	{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}: typed(crd.NewCRDChecker()),
+	{Group: "apiregistration.k8s.io", Kind: "APIService"}:             typed(apiservice.NewAPIServiceChecker()),
+}
+
+// Compute returns the kstatus status of the object. A nil object is NotFound, and an object with a deletion
+// timestamp is Terminating. Built-in kinds are evaluated with the matching checker from this module; other kinds are
+// evaluated using the `Ready`, `Reconciling` and `Stalled` condition conventions. An object that can't be evaluated
+// has Unknown status, and the error is returned.
+func Compute(obj *unstructured.Unstructured) (*Result, error) {
+	if obj == nil {
+		return &Result{Status: NotFoundStatus, Message: "Resource not found"}, nil
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		return &Result{Status: TerminatingStatus, Message: "Resource scheduled for deletion"}, nil
+	}
+
+	compute, ok := computeFuncs[obj.GroupVersionKind().GroupKind()]
+	if !ok {
+		compute = conventions
+	}
+
+	results, err := compute(obj)
+	if err != nil {
+		return &Result{Status: UnknownStatus, Message: err.Error()}, err
+	}
+
+	return FromResults(results), nil
+}
+
+// FromResults maps checker Results to a kstatus status. Ready results are Current, failed results are Failed, and
+// pending results are InProgress.
+func FromResults(results checker.Results) *Result {
+	result := &Result{Results: results}
+	switch results.Status() {
+	case checker.StatusReady:
+		result.Status = CurrentStatus
+		result.Message = "Resource is current"
+	case checker.StatusFailed:
+		result.Status = FailedStatus
+	default:
+		result.Status = InProgressStatus
+	}
+
+	if result.Message == "" && len(results) > 0 {
+		last := results[len(results)-1]
+		result.Message = last.Description
+		if !last.Message.Empty() {
+			result.Message = fmt.Sprintf("%s: %s", last.Description, last.Message)
+		}
+	}
+
+	return result
+}
+
+//
+// Helpers
+//
+
+// typed converts objects to T before evaluating them with the checker.
+func typed[T any](c *checker.StateChecker[*T]) computeFunc {
+	return func(obj *unstructured.Unstructured) (checker.Results, error) {
+		typedObj, err := kubernetes.FromUnstructured[T](obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s %q: %w",
+				obj.GetKind(), kubernetes.FullyQualifiedName(obj), err)
+		}
+
+		_, results := c.ReadyDetails(typedObj)
+		return results, nil
+	}
+}
+
+// conventions evaluates objects of kinds without a dedicated checker using the kstatus condition conventions. A
+// `Stalled` condition means the object failed, a `Reconciling` condition means it is in progress, and a `Ready`
+// condition, if present, must be true.
+func conventions(obj *unstructured.Unstructured) (checker.Results, error) {
+	expected := []generic.ExpectedCondition{
+		{Type: "Stalled", Status: metav1.ConditionFalse, Terminal: true},
+		{Type: "Reconciling", Status: metav1.ConditionFalse},
+	}
+	if hasCondition(obj, "Ready") {
+		expected = append(expected, generic.ExpectedCondition{Type: "Ready", Status: metav1.ConditionTrue})
+	}
+
+	c := generic.NewConditionsChecker(&generic.ConditionsCheckerArgs{Conditions: expected})
+	_, results := c.ReadyDetails(obj)
+	return results, nil
+}
+
+func hasCondition(obj *unstructured.Unstructured, conditionType string) bool {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, condition := range conditions {
+		if c, ok := condition.(map[string]interface{}); ok && c["type"] == conditionType {
+			return true
+		}
+	}
+
+	return false
+}
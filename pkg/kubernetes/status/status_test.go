@@ -0,0 +1,190 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package status
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_Compute(t *testing.T) {
+	tests := []struct {
+		name          string
+		testStatePath string
+		expectStatus  Status
+		expectMessage string
+	}{
+		{
+			name:          "Pod ready",
+			testStatePath: "states/kubernetes/pod/ready.json",
+			expectStatus:  CurrentStatus,
+			expectMessage: "Resource is current",
+		},
+		{
+			name:          "Pod unscheduled",
+			testStatePath: "states/kubernetes/pod/unscheduled.json",
+			expectStatus:  InProgressStatus,
+			expectMessage: `Waiting for Pod "foo" to be scheduled: 0/1 nodes are available: 1 Insufficient memory.
+1 node(s) have insufficient memory for the Pod's request of 128Gi`,
+		},
+		{
+			name:          "Job succeeded",
+			testStatePath: "states/kubernetes/job/succeeded.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "Job backoff limit exceeded",
+			testStatePath: "states/kubernetes/job/backoffLimit.json",
+			expectStatus:  FailedStatus,
+			expectMessage: `Waiting for Job "foo" to succeed (Active: 0 | Succeeded: 0 | Failed: 2): ` +
+				`[BackoffLimitExceeded] Job has reached the specified backoff limit`,
+		},
+		{
+			name:          "CronJob last run succeeded",
+			testStatePath: "states/kubernetes/cronjob/succeeded.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "CronJob with an active Job",
+			testStatePath: "states/kubernetes/cronjob/active.json",
+			expectStatus:  InProgressStatus,
+			expectMessage: `Waiting for CronJob "nightly-export"'s last run to succeed ` +
+				`(Last schedule: 2026-03-16T02:00:00Z | Active: 1): Active Jobs: "nightly-export-29561880"`,
+		},
+		{
+			name:          "PersistentVolumeClaim lost",
+			testStatePath: "states/kubernetes/pvc/lost.json",
+			expectStatus:  FailedStatus,
+			expectMessage: `Waiting for PersistentVolumeClaim "postgres-data" to be bound: ` +
+				`Claim lost its PersistentVolume "pvc-2c3d4e5f-6a7b-4c8d-9e0f-1a2b3c4d5e6f", which no longer exists`,
+		},
+		{
+			name:          "Deployment ready",
+			testStatePath: "states/kubernetes/deployment/ready.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "Deployment progress deadline exceeded",
+			testStatePath: "states/kubernetes/deployment/progressDeadlineExceeded.json",
+			expectStatus:  FailedStatus,
+		},
+		{
+			name:          "StatefulSet updating",
+			testStatePath: "states/kubernetes/statefulset/revisionPending.json",
+			expectStatus:  InProgressStatus,
+		},
+		{
+			name:          "DaemonSet ready",
+			testStatePath: "states/kubernetes/daemonset/ready.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "Service pending load balancer",
+			testStatePath: "states/kubernetes/service/loadBalancerPending.json",
+			expectStatus:  InProgressStatus,
+		},
+		{
+			name:          "Ingress ready",
+			testStatePath: "states/kubernetes/ingress/loadBalancerReady.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "CustomResourceDefinition with conflicting names",
+			testStatePath: "states/kubernetes/crd/nameConflict.json",
+			expectStatus:  FailedStatus,
+			expectMessage: `Waiting for CustomResourceDefinition "gadgets.example.com" names to be accepted: ` +
+				`[PluralConflict] "widgets" is already in use`,
+		},
+		{
+			name:          "APIService available",
+			testStatePath: "states/kubernetes/apiservice/available.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "EndpointSlice without conditions",
+			testStatePath: "states/kubernetes/endpointslice/ready.json",
+			expectStatus:  CurrentStatus,
+		},
+		{
+			name:          "Custom resource not ready",
+			testStatePath: "states/kubernetes/generic/certificateIssuing.json",
+			expectStatus:  InProgressStatus,
+			expectMessage: `Waiting for Certificate "shop-tls" condition "Ready" to be "True": ` +
+				`[DoesNotExist] Issuing certificate as Secret does not exist`,
+		},
+		{
+			name:          "Custom resource ready",
+			testStatePath: "states/kubernetes/generic/certificateReady.json",
+			expectStatus:  CurrentStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := Compute(test.LoadFixture[unstructured.Unstructured](t, tt.testStatePath))
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectStatus, result.Status)
+			if tt.expectMessage != "" {
+				assert.Equal(t, tt.expectMessage, result.Message)
+			}
+		})
+	}
+}
+
+func Test_Compute_Conventions(t *testing.T) {
+	obj := test.LoadFixture[unstructured.Unstructured](t, "states/kubernetes/generic/certificateReady.json")
+
+	conditions := []interface{}{
+		map[string]interface{}{"type": "Ready", "status": "True"},
+		map[string]interface{}{"type": "Reconciling", "status": "True", "reason": "Progressing"},
+	}
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, conditions, "status", "conditions"))
+	result, err := Compute(obj)
+	require.NoError(t, err)
+	assert.Equal(t, InProgressStatus, result.Status)
+
+	conditions = append(conditions, map[string]interface{}{
+		"type": "Stalled", "status": "True", "reason": "IssuerNotFound", "message": `Issuer "letsencrypt" not found`,
+	})
+	require.NoError(t, unstructured.SetNestedSlice(obj.Object, conditions, "status", "conditions"))
+	result, err = Compute(obj)
+	require.NoError(t, err)
+	assert.Equal(t, FailedStatus, result.Status)
+	assert.Equal(t, `Waiting for Certificate "shop-tls" condition "Stalled" to be "False": `+
+		`[IssuerNotFound] Issuer "letsencrypt" not found`, result.Message)
+}
+
+func Test_Compute_Special(t *testing.T) {
+	result, err := Compute(nil)
+	require.NoError(t, err)
+	assert.Equal(t, NotFoundStatus, result.Status)
+
+	terminating := test.LoadFixture[unstructured.Unstructured](t, "states/kubernetes/pod/ready.json")
+	terminating.SetDeletionTimestamp(&metav1.Time{Time: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)})
+	result, err = Compute(terminating)
+	require.NoError(t, err)
+	assert.Equal(t, TerminatingStatus, result.Status)
+
+	invalid := test.LoadFixture[unstructured.Unstructured](t, "states/kubernetes/deployment/ready.json")
+	require.NoError(t, unstructured.SetNestedField(invalid.Object, "two", "spec", "replicas"))
+	result, err = Compute(invalid)
+	assert.Error(t, err)
+	assert.Equal(t, UnknownStatus, result.Status)
+}
@@ -0,0 +1,52 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package builder synthesizes Pod and Job states for tests with fluent constructors, e.g.,
+//
+//	builder.NewPod("foo").Scheduled().WithContainer("nginx").Waiting("ImagePullBackOff", msg).Build()
+//
+// The builders fill in the fields that the Kubernetes controllers would set, such as the Pod conditions that follow
+// from the container statuses, so that a test only has to describe what is relevant to it. The objects use fixed
+// timestamps and UIDs derived from their names, so that the results of the checkers are deterministic.
+package builder
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// DefaultNamespace is the namespace of the built objects, unless InNamespace is used.
+const DefaultNamespace = "default"
+
+// DefaultTime is the creation timestamp of the built objects, unless CreatedAt is used. It is also used for the other
+// timestamps of an object, such as the last transition time of its conditions.
+var DefaultTime = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// uid returns a UID that is unique to the kind and name of an object, and stable across runs.
+func uid(kind, name string) types.UID {
+	sum := sha1.Sum([]byte(kind + "/" + name))
+	return types.UID(fmt.Sprintf("%x-%x-%x-%x-%x", sum[0:4], sum[4:6], sum[6:8], sum[8:10], sum[10:16]))
+}
+
+func timePtr(t metav1.Time) *metav1.Time {
+	return &t
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
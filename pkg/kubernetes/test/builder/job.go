@@ -0,0 +1,227 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// JobBuilder builds Jobs. Its methods modify the builder and return it, so that calls can be chained.
+type JobBuilder struct {
+	job   *batchv1.Job
+	steps []*batchv1.Job
+}
+
+// NewJob returns a builder for a Job that has not started yet, with a single completion and the default backoff limit.
+func NewJob(name string) *JobBuilder {
+	return &JobBuilder{job: &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{APIVersion: "batch/v1", Kind: "Job"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         DefaultNamespace,
+			UID:               uid("Job", name),
+			CreationTimestamp: metav1.NewTime(DefaultTime),
+		},
+		Spec: batchv1.JobSpec{
+			Completions:    ptr(int32(1)),
+			Parallelism:    ptr(int32(1)),
+			BackoffLimit:   ptr(int32(6)),
+			CompletionMode: ptr(batchv1.NonIndexedCompletion),
+			Suspend:        ptr(false),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{RestartPolicy: corev1.RestartPolicyNever},
+			},
+		},
+	}}
+}
+
+// InNamespace sets the namespace of the Job.
+func (b *JobBuilder) InNamespace(namespace string) *JobBuilder {
+	b.job.Namespace = namespace
+	return b
+}
+
+// CreatedAt sets the creation timestamp of the Job, which is also used for its other timestamps.
+func (b *JobBuilder) CreatedAt(t time.Time) *JobBuilder {
+	b.job.CreationTimestamp = metav1.NewTime(t)
+	return b
+}
+
+// WithContainer adds a container to the Job's Pod template.
+func (b *JobBuilder) WithContainer(name string) *JobBuilder {
+	b.job.Spec.Template.Spec.Containers = append(b.job.Spec.Template.Spec.Containers,
+		corev1.Container{Name: name, Image: name})
+	return b
+}
+
+// WithRestartPolicy sets the restartPolicy of the Job's Pod template, which defaults to Never.
+func (b *JobBuilder) WithRestartPolicy(policy corev1.RestartPolicy) *JobBuilder {
+	b.job.Spec.Template.Spec.RestartPolicy = policy
+	return b
+}
+
+// WithCompletions sets the number of Pods that have to succeed for the Job to complete.
+func (b *JobBuilder) WithCompletions(completions int32) *JobBuilder {
+	b.job.Spec.Completions = ptr(completions)
+	return b
+}
+
+// WithParallelism sets the maximum number of Pods that run at the same time.
+func (b *JobBuilder) WithParallelism(parallelism int32) *JobBuilder {
+	b.job.Spec.Parallelism = ptr(parallelism)
+	return b
+}
+
+// WithBackoffLimit sets the number of retries before the Job is marked as failed.
+func (b *JobBuilder) WithBackoffLimit(limit int32) *JobBuilder {
+	b.job.Spec.BackoffLimit = ptr(limit)
+	return b
+}
+
+// Indexed sets the completion mode of the Job to Indexed.
+func (b *JobBuilder) Indexed() *JobBuilder {
+	b.job.Spec.CompletionMode = ptr(batchv1.IndexedCompletion)
+	return b
+}
+
+// Suspended suspends the Job.
+func (b *JobBuilder) Suspended() *JobBuilder {
+	b.job.Spec.Suspend = ptr(true)
+	b.job.Status.Active = 0
+	return b.WithCondition(batchv1.JobSuspended, corev1.ConditionTrue, "JobSuspended", "Job suspended")
+}
+
+// Resumed resumes a suspended Job.
+func (b *JobBuilder) Resumed() *JobBuilder {
+	b.job.Spec.Suspend = ptr(false)
+	return b.WithCondition(batchv1.JobSuspended, corev1.ConditionFalse, "JobResumed", "Job resumed")
+}
+
+// Started sets the start time of the Job, and its number of active Pods to its parallelism.
+func (b *JobBuilder) Started() *JobBuilder {
+	b.job.Status.StartTime = timePtr(b.job.CreationTimestamp)
+	b.job.Status.Active = *b.job.Spec.Parallelism
+	return b
+}
+
+// WithPodCounts sets the number of active, succeeded and failed Pods of the Job.
+func (b *JobBuilder) WithPodCounts(active, succeeded, failed int32) *JobBuilder {
+	b.job.Status.Active = active
+	b.job.Status.Succeeded = succeeded
+	b.job.Status.Failed = failed
+	return b
+}
+
+// WithCompletedIndexes sets the completed indexes of an Indexed Job, e.g., "0-2,4".
+func (b *JobBuilder) WithCompletedIndexes(indexes string) *JobBuilder {
+	b.job.Status.CompletedIndexes = indexes
+	return b
+}
+
+// WithFailedIndexes sets the failed indexes of an Indexed Job, e.g., "3".
+func (b *JobBuilder) WithFailedIndexes(indexes string) *JobBuilder {
+	b.job.Status.FailedIndexes = ptr(indexes)
+	return b
+}
+
+// WithCondition sets a condition of the Job, replacing any condition of the same type.
+func (b *JobBuilder) WithCondition(
+	conditionType batchv1.JobConditionType, status corev1.ConditionStatus, reason, message string,
+) *JobBuilder {
+	condition := batchv1.JobCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      b.job.CreationTimestamp,
+		LastTransitionTime: b.job.CreationTimestamp,
+	}
+	for i := range b.job.Status.Conditions {
+		if b.job.Status.Conditions[i].Type == conditionType {
+			b.job.Status.Conditions[i] = condition
+			return b
+		}
+	}
+	b.job.Status.Conditions = append(b.job.Status.Conditions, condition)
+	return b
+}
+
+// Complete completes the Job, i.e., sets the number of succeeded Pods to its completions, and adds the
+// SuccessCriteriaMet and Complete conditions.
+func (b *JobBuilder) Complete() *JobBuilder {
+	b.start()
+	b.job.Status.Active = 0
+	b.job.Status.Succeeded = *b.job.Spec.Completions
+	b.job.Status.CompletionTime = timePtr(b.job.CreationTimestamp)
+
+	msg := "Reached expected number of succeeded pods"
+	b.WithCondition(batchv1.JobSuccessCriteriaMet, corev1.ConditionTrue, batchv1.JobReasonCompletionsReached, msg)
+	return b.WithCondition(batchv1.JobComplete, corev1.ConditionTrue, batchv1.JobReasonCompletionsReached, msg)
+}
+
+// Failed fails the Job with the provided reason and message, e.g.,
+// Failed(batchv1.JobReasonBackoffLimitExceeded, "Job has reached the specified backoff limit"), by adding the
+// FailureTarget and Failed conditions.
+func (b *JobBuilder) Failed(reason, message string) *JobBuilder {
+	b.start()
+	b.job.Status.Active = 0
+
+	b.WithCondition(batchv1.JobFailureTarget, corev1.ConditionTrue, reason, message)
+	return b.WithCondition(batchv1.JobFailed, corev1.ConditionTrue, reason, message)
+}
+
+// Pod returns a builder for a Pod that is controlled by the Job, and has the containers and restartPolicy of the Job's
+// Pod template. Changes to the Job after Pod is called are not reflected in the Pod.
+func (b *JobBuilder) Pod(name string) *PodBuilder {
+	pod := NewPod(name).
+		InNamespace(b.job.Namespace).
+		CreatedAt(b.job.CreationTimestamp.Time).
+		WithRestartPolicy(b.job.Spec.Template.Spec.RestartPolicy).
+		WithLabel(batchv1.ControllerUidLabel, string(b.job.UID)).
+		WithLabel(batchv1.JobNameLabel, b.job.Name).
+		ControlledBy(b.job, batchv1.SchemeGroupVersion.WithKind("Job"))
+	for _, container := range b.job.Spec.Template.Spec.Containers {
+		pod.WithContainer(container.Name)
+	}
+
+	return pod
+}
+
+// Build returns the Job.
+func (b *JobBuilder) Build() *batchv1.Job {
+	return b.job.DeepCopy()
+}
+
+// Step records the Job as it is now as a step of the workflow returned by Workflow.
+func (b *JobBuilder) Step() *JobBuilder {
+	b.steps = append(b.steps, b.Build())
+	return b
+}
+
+// Workflow returns the steps recorded with Step, followed by the Job as it is now.
+func (b *JobBuilder) Workflow() []*batchv1.Job {
+	return append(append([]*batchv1.Job(nil), b.steps...), b.Build())
+}
+
+// start sets the start time of the Job if it has not started yet.
+func (b *JobBuilder) start() {
+	if b.job.Status.StartTime == nil {
+		b.job.Status.StartTime = timePtr(b.job.CreationTimestamp)
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_JobBuilder(t *testing.T) {
+	tests := []struct {
+		name          string
+		job           *JobBuilder
+		expectReady   bool
+		expectFailed  bool
+		expectMessage string
+	}{
+		{
+			name:          "Suspended",
+			job:           NewJob("foo").Suspended(),
+			expectMessage: "[JobSuspended] Job suspended",
+		},
+		{
+			name: "Not started",
+			job:  NewJob("foo").Suspended().Resumed(),
+		},
+		{
+			name: "Started",
+			job:  NewJob("foo").Started(),
+		},
+		{
+			name:          "Backoff limit exceeded",
+			job:           NewJob("foo").Started().WithPodCounts(0, 0, 7).Failed(batchv1.JobReasonBackoffLimitExceeded, "Job has reached the specified backoff limit"),
+			expectFailed:  true,
+			expectMessage: "[BackoffLimitExceeded] Job has reached the specified backoff limit",
+		},
+		{
+			name:          "Indexed",
+			job:           NewJob("foo").Indexed().WithCompletions(3).Started().WithPodCounts(1, 2, 0).WithCompletedIndexes("0-1"),
+			expectMessage: "2/3 indexes complete",
+		},
+		{
+			name:        "Complete",
+			job:         NewJob("foo").WithCompletions(2).Started().Complete(),
+			expectReady: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, results := job.NewJobChecker().ReadyDetails(tt.job.Build())
+			assert.Equal(t, tt.expectReady, ready)
+			assert.Equal(t, tt.expectFailed, results.Status() == checker.StatusFailed)
+			if tt.expectMessage != "" {
+				assert.Contains(t, results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_JobBuilder_Pod(t *testing.T) {
+	b := NewJob("foo").InNamespace("bar").WithContainer("main").Started()
+	j := b.Build()
+	p := b.Pod("foo-x7k2p").Scheduled().Waiting("ImagePullBackOff", `Back-off pulling image "main"`).Build()
+
+	assert.Equal(t, "bar", p.Namespace)
+	assert.Equal(t, corev1.RestartPolicyNever, p.Spec.RestartPolicy)
+	assert.Equal(t, string(j.UID), p.Labels[batchv1.ControllerUidLabel])
+	assert.Equal(t, []*corev1.Pod{p}, job.OwnedPods(j, []*corev1.Pod{p, NewPod("other").Build()}))
+
+	ready, result := job.NewJobWithPodsChecker().ReadyStatus(&job.State{Job: j, Pods: []*corev1.Pod{p}})
+	assert.False(t, ready)
+	assert.Contains(t, result.Message.S, `[ImagePullBackOff] Back-off pulling image "main"`)
+}
+
+func Test_JobBuilder_Workflow(t *testing.T) {
+	jobs := NewJob("foo").Step().Started().Step().Complete().Workflow()
+	require.Len(t, jobs, 3)
+	assert.Nil(t, jobs[0].Status.StartTime)
+	assert.Equal(t, int32(1), jobs[1].Status.Active)
+	assert.Empty(t, jobs[1].Status.Conditions)
+	assert.Equal(t, int32(1), jobs[2].Status.Succeeded)
+	assert.True(t, job.NewJobChecker().Ready(jobs[2]))
+}
@@ -0,0 +1,426 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodBuilder builds Pods. Its methods modify the builder and return it, so that calls can be chained. The methods that
+// set the state of a container apply to the container that was added last.
+type PodBuilder struct {
+	pod   *corev1.Pod
+	steps []*corev1.Pod
+	// The container that was added last, if any.
+	current *containerRef
+}
+
+type containerRef struct {
+	init  bool
+	index int
+}
+
+// NewPod returns a builder for a Pod that has not been scheduled yet, and has no containers.
+func NewPod(name string) *PodBuilder {
+	return &PodBuilder{pod: &corev1.Pod{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         DefaultNamespace,
+			UID:               uid("Pod", name),
+			CreationTimestamp: metav1.NewTime(DefaultTime),
+		},
+		Spec: corev1.PodSpec{RestartPolicy: corev1.RestartPolicyAlways},
+	}}
+}
+
+// InNamespace sets the namespace of the Pod.
+func (b *PodBuilder) InNamespace(namespace string) *PodBuilder {
+	b.pod.Namespace = namespace
+	return b
+}
+
+// CreatedAt sets the creation timestamp of the Pod, which is also used for its other timestamps.
+func (b *PodBuilder) CreatedAt(t time.Time) *PodBuilder {
+	b.pod.CreationTimestamp = metav1.NewTime(t)
+	return b
+}
+
+// WithLabel adds a label to the Pod.
+func (b *PodBuilder) WithLabel(key, value string) *PodBuilder {
+	if b.pod.Labels == nil {
+		b.pod.Labels = map[string]string{}
+	}
+	b.pod.Labels[key] = value
+	return b
+}
+
+// WithRestartPolicy sets the restartPolicy of the Pod, which defaults to Always.
+func (b *PodBuilder) WithRestartPolicy(policy corev1.RestartPolicy) *PodBuilder {
+	b.pod.Spec.RestartPolicy = policy
+	return b
+}
+
+// ControlledBy adds a controller owner reference to the owner, which is of the provided kind.
+func (b *PodBuilder) ControlledBy(owner metav1.Object, gvk schema.GroupVersionKind) *PodBuilder {
+	b.pod.OwnerReferences = append(b.pod.OwnerReferences, *metav1.NewControllerRef(owner, gvk))
+	return b
+}
+
+// WithContainer adds a container, which is waiting to be created, and makes it the current container.
+func (b *PodBuilder) WithContainer(name string) *PodBuilder {
+	b.pod.Spec.Containers = append(b.pod.Spec.Containers, corev1.Container{Name: name, Image: name})
+	b.pod.Status.ContainerStatuses = append(b.pod.Status.ContainerStatuses,
+		newContainerStatus(name, "ContainerCreating"))
+	b.current = &containerRef{index: len(b.pod.Status.ContainerStatuses) - 1}
+	return b
+}
+
+// WithInitContainer adds an init container, which is waiting to be created, and makes it the current container.
+func (b *PodBuilder) WithInitContainer(name string) *PodBuilder {
+	return b.withInitContainer(corev1.Container{Name: name, Image: name})
+}
+
+// WithSidecar adds a native sidecar, i.e., an init container with restartPolicy: Always, which is waiting to be
+// created, and makes it the current container.
+func (b *PodBuilder) WithSidecar(name string) *PodBuilder {
+	return b.withInitContainer(corev1.Container{
+		Name: name, Image: name, RestartPolicy: ptr(corev1.ContainerRestartPolicyAlways)})
+}
+
+func (b *PodBuilder) withInitContainer(container corev1.Container) *PodBuilder {
+	b.pod.Spec.InitContainers = append(b.pod.Spec.InitContainers, container)
+	b.pod.Status.InitContainerStatuses = append(b.pod.Status.InitContainerStatuses,
+		newContainerStatus(container.Name, "PodInitializing"))
+	b.current = &containerRef{init: true, index: len(b.pod.Status.InitContainerStatuses) - 1}
+	return b
+}
+
+// WithMemoryLimit sets the memory limit of the current container, e.g., "128Mi".
+func (b *PodBuilder) WithMemoryLimit(limit string) *PodBuilder {
+	container := b.currentContainer()
+	if container.Resources.Limits == nil {
+		container.Resources.Limits = corev1.ResourceList{}
+	}
+	container.Resources.Limits[corev1.ResourceMemory] = resource.MustParse(limit)
+	return b
+}
+
+// Waiting sets the current container to waiting, e.g., Waiting("ImagePullBackOff", `Back-off pulling image "nginx"`).
+func (b *PodBuilder) Waiting(reason, message string) *PodBuilder {
+	status := b.currentStatus()
+	status.State = corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: reason, Message: message}}
+	status.Ready = false
+	status.Started = ptr(false)
+	return b
+}
+
+// Running sets the current container to running. Running containers are ready, except for init containers that are not
+// sidecars.
+func (b *PodBuilder) Running() *PodBuilder {
+	b.setRunning(b.currentRef())
+	return b
+}
+
+// NotReady marks the current container as not ready, e.g., because its readiness probe is failing.
+func (b *PodBuilder) NotReady() *PodBuilder {
+	b.currentStatus().Ready = false
+	return b
+}
+
+// Terminated sets the current container to terminated, e.g., Terminated(1, "Error", "").
+func (b *PodBuilder) Terminated(exitCode int32, reason, message string) *PodBuilder {
+	b.setTerminated(b.currentRef(), exitCode, reason, message)
+	return b
+}
+
+// Restarted sets the last termination state of the current container, and increments its restart count. It is usually
+// followed by Running, or by Waiting("CrashLoopBackOff", ...).
+func (b *PodBuilder) Restarted(exitCode int32, reason, message string) *PodBuilder {
+	status := b.currentStatus()
+	status.LastTerminationState = corev1.ContainerState{Terminated: b.terminatedState(exitCode, reason, message)}
+	status.RestartCount++
+	return b
+}
+
+// Scheduled marks the Pod as scheduled. Once a Pod is scheduled, its Initialized, ContainersReady and Ready conditions
+// are set according to the state of its containers, unless they are set with WithCondition.
+func (b *PodBuilder) Scheduled() *PodBuilder {
+	return b.WithCondition(corev1.PodScheduled, corev1.ConditionTrue, "", "")
+}
+
+// Unschedulable marks the Pod as unschedulable with the message of the scheduler, e.g.,
+// "0/3 nodes are available: 3 Insufficient cpu.".
+func (b *PodBuilder) Unschedulable(message string) *PodBuilder {
+	return b.WithCondition(corev1.PodScheduled, corev1.ConditionFalse, corev1.PodReasonUnschedulable, message)
+}
+
+// WithCondition sets a condition of the Pod, replacing any condition of the same type.
+func (b *PodBuilder) WithCondition(
+	conditionType corev1.PodConditionType, status corev1.ConditionStatus, reason, message string,
+) *PodBuilder {
+	condition := corev1.PodCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: b.pod.CreationTimestamp,
+	}
+	for i := range b.pod.Status.Conditions {
+		if b.pod.Status.Conditions[i].Type == conditionType {
+			b.pod.Status.Conditions[i] = condition
+			return b
+		}
+	}
+	b.pod.Status.Conditions = append(b.pod.Status.Conditions, condition)
+	return b
+}
+
+// Ready schedules the Pod, completes its init containers and runs its sidecars and containers.
+func (b *PodBuilder) Ready() *PodBuilder {
+	b.Scheduled()
+	for i := range b.pod.Status.InitContainerStatuses {
+		ref := containerRef{init: true, index: i}
+		if kubernetes.IsSidecar(b.container(ref)) {
+			b.setRunning(ref)
+		} else {
+			b.setTerminated(ref, 0, "Completed", "")
+		}
+	}
+	for i := range b.pod.Status.ContainerStatuses {
+		b.setRunning(containerRef{index: i})
+	}
+	return b
+}
+
+// Succeeded schedules the Pod, terminates all of its containers with exit code 0, and sets its phase to Succeeded.
+func (b *PodBuilder) Succeeded() *PodBuilder {
+	b.Scheduled()
+	for i := range b.pod.Status.InitContainerStatuses {
+		b.setTerminated(containerRef{init: true, index: i}, 0, "Completed", "")
+	}
+	for i := range b.pod.Status.ContainerStatuses {
+		b.setTerminated(containerRef{index: i}, 0, "Completed", "")
+	}
+	b.pod.Status.Phase = corev1.PodSucceeded
+	return b
+}
+
+// Failed sets the phase of the Pod to Failed, with the provided reason and message, e.g.,
+// Failed("Evicted", "The node was low on resource: memory.").
+func (b *PodBuilder) Failed(reason, message string) *PodBuilder {
+	b.pod.Status.Phase = corev1.PodFailed
+	b.pod.Status.Reason = reason
+	b.pod.Status.Message = message
+	return b
+}
+
+// Build returns the Pod. Unless they are set explicitly, the phase of a scheduled Pod is derived from the state of its
+// containers, and its Initialized, ContainersReady and Ready conditions are set the way the kubelet would set them.
+func (b *PodBuilder) Build() *corev1.Pod {
+	pod := b.pod.DeepCopy()
+
+	scheduled := false
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionTrue {
+			scheduled = true
+		}
+	}
+	if !scheduled {
+		if len(pod.Status.Phase) == 0 {
+			pod.Status.Phase = corev1.PodPending
+		}
+		return pod
+	}
+
+	pod.Status.StartTime = timePtr(pod.CreationTimestamp)
+
+	var incomplete []string
+	for _, status := range pod.Status.InitContainerStatuses {
+		if kubernetes.IsSidecar(findContainer(pod.Spec.InitContainers, status.Name)) {
+			if status.Started == nil || !*status.Started {
+				incomplete = append(incomplete, status.Name)
+			}
+			continue
+		}
+		if terminated := status.State.Terminated; terminated == nil || terminated.ExitCode != 0 {
+			incomplete = append(incomplete, status.Name)
+		}
+	}
+
+	var unready []string
+	running := false
+	for i, status := range pod.Status.ContainerStatuses {
+		if waiting := status.State.Waiting; waiting != nil && waiting.Reason == "ContainerCreating" && len(incomplete) > 0 {
+			// Containers are not created until the Pod is initialized.
+			pod.Status.ContainerStatuses[i].State.Waiting.Reason = "PodInitializing"
+		}
+		if status.State.Running != nil {
+			running = true
+		}
+		if !status.Ready {
+			unready = append(unready, status.Name)
+		}
+	}
+
+	if len(pod.Status.Phase) == 0 {
+		pod.Status.Phase = corev1.PodPending
+		if running {
+			pod.Status.Phase = corev1.PodRunning
+		}
+	}
+
+	initialized := podCondition(corev1.PodInitialized, corev1.ConditionTrue, "", "")
+	if len(incomplete) > 0 {
+		initialized = podCondition(corev1.PodInitialized, corev1.ConditionFalse, "ContainersNotInitialized",
+			fmt.Sprintf("containers with incomplete status: [%s]", strings.Join(incomplete, " ")))
+	}
+	ready := podCondition(corev1.PodReady, corev1.ConditionTrue, "", "")
+	switch {
+	case pod.Status.Phase == corev1.PodSucceeded:
+		ready = podCondition(corev1.PodReady, corev1.ConditionFalse, "PodCompleted", "")
+	case pod.Status.Phase == corev1.PodFailed:
+		ready = podCondition(corev1.PodReady, corev1.ConditionFalse, "PodFailed", "")
+	case len(pod.Status.ContainerStatuses) == 0:
+		ready = podCondition(corev1.PodReady, corev1.ConditionFalse, "ContainersNotReady", "")
+	case len(unready) > 0 || len(incomplete) > 0:
+		ready = podCondition(corev1.PodReady, corev1.ConditionFalse, "ContainersNotReady",
+			fmt.Sprintf("containers with unready status: [%s]", strings.Join(unready, " ")))
+	}
+	containersReady := ready
+	containersReady.Type = corev1.ContainersReady
+
+	// The derived conditions come first, in the order the kubelet reports them.
+	var derived []corev1.PodCondition
+	for _, condition := range []corev1.PodCondition{initialized, ready, containersReady} {
+		if !hasCondition(pod.Status.Conditions, condition.Type) {
+			condition.LastTransitionTime = pod.CreationTimestamp
+			derived = append(derived, condition)
+		}
+	}
+	pod.Status.Conditions = append(derived, pod.Status.Conditions...)
+
+	return pod
+}
+
+// Step records the Pod as it is now as a step of the workflow returned by Workflow.
+func (b *PodBuilder) Step() *PodBuilder {
+	b.steps = append(b.steps, b.Build())
+	return b
+}
+
+// Workflow returns the steps recorded with Step, followed by the Pod as it is now, e.g.,
+//
+//	NewPod("foo").WithContainer("nginx").Step().Scheduled().Step().Ready().Workflow()
+//
+// returns a pending, a scheduled and a ready Pod.
+func (b *PodBuilder) Workflow() []*corev1.Pod {
+	return append(append([]*corev1.Pod(nil), b.steps...), b.Build())
+}
+
+func (b *PodBuilder) setRunning(ref containerRef) {
+	status := b.status(ref)
+	status.State = corev1.ContainerState{Running: &corev1.ContainerStateRunning{StartedAt: b.pod.CreationTimestamp}}
+	status.Started = ptr(true)
+	status.Ready = !ref.init || kubernetes.IsSidecar(b.container(ref))
+}
+
+func (b *PodBuilder) setTerminated(ref containerRef, exitCode int32, reason, message string) {
+	status := b.status(ref)
+	status.State = corev1.ContainerState{Terminated: b.terminatedState(exitCode, reason, message)}
+	status.Started = ptr(false)
+	// Init containers that completed are reported as ready.
+	status.Ready = ref.init && !kubernetes.IsSidecar(b.container(ref)) && exitCode == 0
+}
+
+func (b *PodBuilder) terminatedState(exitCode int32, reason, message string) *corev1.ContainerStateTerminated {
+	return &corev1.ContainerStateTerminated{
+		ExitCode:   exitCode,
+		Reason:     reason,
+		Message:    message,
+		StartedAt:  b.pod.CreationTimestamp,
+		FinishedAt: b.pod.CreationTimestamp,
+	}
+}
+
+func (b *PodBuilder) currentRef() containerRef {
+	if b.current == nil {
+		panic("builder: the Pod has no containers; call WithContainer first")
+	}
+	return *b.current
+}
+
+func (b *PodBuilder) currentStatus() *corev1.ContainerStatus {
+	return b.status(b.currentRef())
+}
+
+func (b *PodBuilder) currentContainer() *corev1.Container {
+	return b.container(b.currentRef())
+}
+
+func (b *PodBuilder) status(ref containerRef) *corev1.ContainerStatus {
+	if ref.init {
+		return &b.pod.Status.InitContainerStatuses[ref.index]
+	}
+	return &b.pod.Status.ContainerStatuses[ref.index]
+}
+
+func (b *PodBuilder) container(ref containerRef) *corev1.Container {
+	if ref.init {
+		return &b.pod.Spec.InitContainers[ref.index]
+	}
+	return &b.pod.Spec.Containers[ref.index]
+}
+
+func newContainerStatus(name, waitingReason string) corev1.ContainerStatus {
+	return corev1.ContainerStatus{
+		Name:    name,
+		Image:   name,
+		Started: ptr(false),
+		State:   corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: waitingReason}},
+	}
+}
+
+func podCondition(
+	conditionType corev1.PodConditionType, status corev1.ConditionStatus, reason, message string,
+) corev1.PodCondition {
+	return corev1.PodCondition{Type: conditionType, Status: status, Reason: reason, Message: message}
+}
+
+func hasCondition(conditions []corev1.PodCondition, conditionType corev1.PodConditionType) bool {
+	for _, condition := range conditions {
+		if condition.Type == conditionType {
+			return true
+		}
+	}
+	return false
+}
+
+func findContainer(containers []corev1.Container, name string) *corev1.Container {
+	for i := range containers {
+		if containers[i].Name == name {
+			return &containers[i]
+		}
+	}
+	return nil
+}
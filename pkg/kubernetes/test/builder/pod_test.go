@@ -0,0 +1,186 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package builder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/pod"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_PodBuilder(t *testing.T) {
+	tests := []struct {
+		name          string
+		pod           *PodBuilder
+		expectReady   bool
+		expectFailed  bool
+		expectMessage string
+	}{
+		{
+			name: "Unscheduled",
+			pod:  NewPod("foo").WithContainer("nginx"),
+		},
+		{
+			name:          "Unschedulable",
+			pod:           NewPod("foo").WithContainer("nginx").Unschedulable("0/3 nodes are available: 3 Insufficient cpu."),
+			expectMessage: "0/3 nodes are available: 3 Insufficient cpu.",
+		},
+		{
+			name:          "Image pull backoff",
+			pod:           NewPod("foo").Scheduled().WithContainer("nginx").Waiting("ImagePullBackOff", `Back-off pulling image "nginx:x"`),
+			expectMessage: `[ImagePullBackOff] Back-off pulling image "nginx:x"`,
+		},
+		{
+			name:          "Invalid image name",
+			pod:           NewPod("foo").Scheduled().WithContainer("nginx").Waiting("InvalidImageName", `couldn't parse image name "nginx:"`),
+			expectFailed:  true,
+			expectMessage: `[InvalidImageName] couldn't parse image name "nginx:"`,
+		},
+		{
+			name: "Init container crash loop",
+			pod: NewPod("foo").Scheduled().
+				WithInitContainer("migrate").Restarted(2, "Error", "relation exists").Waiting("CrashLoopBackOff", "back-off 10s").
+				WithContainer("web"),
+			expectMessage: "[CrashLoopBackOff] back-off 10s\nInit container \"migrate\" failed (exit code 2)\nrelation exists",
+		},
+		{
+			name: "Init container failed with restartPolicy Never",
+			pod: NewPod("foo").Scheduled().WithRestartPolicy(corev1.RestartPolicyNever).
+				WithInitContainer("migrate").Terminated(2, "Error", "relation exists").
+				WithContainer("web"),
+			expectFailed:  true,
+			expectMessage: `Init container "migrate" failed (exit code 2)`,
+		},
+		{
+			name:          "Sidecar not started",
+			pod:           NewPod("foo").Scheduled().WithSidecar("proxy").Waiting("ImagePullBackOff", "Back-off").WithContainer("web"),
+			expectMessage: `Sidecar container "proxy" has not started`,
+		},
+		{
+			name: "OOMKilled",
+			pod: NewPod("foo").Scheduled().
+				WithContainer("app").WithMemoryLimit("64Mi").Restarted(137, "OOMKilled", "").Running().NotReady(),
+			expectMessage: `memory limit of 64Mi`,
+		},
+		{
+			name:          "Evicted",
+			pod:           NewPod("foo").Scheduled().WithContainer("nginx").Terminated(137, "Error", "").Failed("Evicted", "The node was low on resource: memory."),
+			expectFailed:  true,
+			expectMessage: "[Evicted] The node was low on resource: memory.",
+		},
+		{
+			name:        "Ready",
+			pod:         NewPod("foo").WithInitContainer("migrate").WithSidecar("proxy").WithContainer("web").Ready(),
+			expectReady: true,
+		},
+		{
+			name:        "Succeeded",
+			pod:         NewPod("foo").WithRestartPolicy(corev1.RestartPolicyNever).WithContainer("job").Succeeded(),
+			expectReady: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ready, results := pod.NewPodChecker().ReadyDetails(tt.pod.Build())
+			assert.Equal(t, tt.expectReady, ready)
+			assert.Equal(t, tt.expectFailed, results.Status() == checker.StatusFailed)
+			if tt.expectMessage != "" {
+				assert.Contains(t, results.String(), tt.expectMessage)
+			}
+		})
+	}
+}
+
+func Test_PodBuilder_Build(t *testing.T) {
+	p := NewPod("foo").InNamespace("bar").Scheduled().
+		WithInitContainer("migrate").Running().
+		WithContainer("web").
+		Build()
+
+	assert.Equal(t, "bar", p.Namespace)
+	assert.Equal(t, corev1.PodPending, p.Status.Phase)
+	assert.Equal(t, []corev1.PodCondition{
+		{
+			Type: corev1.PodInitialized, Status: corev1.ConditionFalse, Reason: "ContainersNotInitialized",
+			Message: "containers with incomplete status: [migrate]", LastTransitionTime: p.CreationTimestamp,
+		},
+		{
+			Type: corev1.PodReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady",
+			Message: "containers with unready status: [web]", LastTransitionTime: p.CreationTimestamp,
+		},
+		{
+			Type: corev1.ContainersReady, Status: corev1.ConditionFalse, Reason: "ContainersNotReady",
+			Message: "containers with unready status: [web]", LastTransitionTime: p.CreationTimestamp,
+		},
+		{Type: corev1.PodScheduled, Status: corev1.ConditionTrue, LastTransitionTime: p.CreationTimestamp},
+	}, p.Status.Conditions)
+	assert.Equal(t, "PodInitializing", p.Status.ContainerStatuses[0].State.Waiting.Reason)
+
+	// Explicit conditions are not overridden.
+	p = NewPod("foo").Scheduled().WithContainer("web").Running().
+		WithCondition(corev1.PodReady, corev1.ConditionFalse, "ReadinessGatesNotReady", "").
+		Build()
+	assert.Equal(t, corev1.PodRunning, p.Status.Phase)
+	require.Len(t, p.Status.Conditions, 4)
+	assert.Equal(t, corev1.PodReady, p.Status.Conditions[3].Type)
+	assert.Equal(t, "ReadinessGatesNotReady", p.Status.Conditions[3].Reason)
+
+	// Pods without containers and failed Pods are never ready.
+	p = NewPod("foo").Scheduled().Build()
+	require.Len(t, p.Status.Conditions, 4)
+	assert.Equal(t, corev1.ConditionFalse, p.Status.Conditions[1].Status)
+	assert.Equal(t, "ContainersNotReady", p.Status.Conditions[1].Reason)
+	assert.Equal(t, corev1.ConditionFalse, p.Status.Conditions[2].Status)
+
+	p = NewPod("foo").Scheduled().WithContainer("web").Running().Failed("Evicted", "").Build()
+	require.Len(t, p.Status.Conditions, 4)
+	assert.Equal(t, corev1.ConditionFalse, p.Status.Conditions[1].Status)
+	assert.Equal(t, "PodFailed", p.Status.Conditions[1].Reason)
+	assert.Equal(t, corev1.ConditionFalse, p.Status.Conditions[2].Status)
+
+	assert.PanicsWithValue(t, "builder: the Pod has no containers; call WithContainer first", func() {
+		NewPod("foo").Running()
+	})
+}
+
+func Test_PodBuilder_Workflow(t *testing.T) {
+	b := NewPod("foo").WithContainer("nginx")
+	pods := b.Step().
+		Scheduled().Step().
+		Waiting("ErrImagePull", "rpc error").Step().
+		Ready().
+		Workflow()
+	require.Len(t, pods, 4)
+
+	// Steps are snapshots, so later changes to the builder don't affect them.
+	assert.Equal(t, corev1.PodPending, pods[0].Status.Phase)
+	assert.Empty(t, pods[0].Status.Conditions)
+	assert.Equal(t, "ErrImagePull", pods[2].Status.ContainerStatuses[0].State.Waiting.Reason)
+	assert.Equal(t, corev1.PodRunning, pods[3].Status.Phase)
+
+	var steps []checker.Results
+	result := checker.Await(context.Background(), pod.NewPodChecker(), test.Stream(pods), func(results checker.Results) {
+		steps = append(steps, results)
+	})
+	assert.True(t, result.Ready())
+	assert.Len(t, steps, 4)
+	assert.Contains(t, steps[2].String(), "[ErrImagePull] rpc error")
+}
@@ -0,0 +1,54 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/internal"
+	"github.com/stretchr/testify/require"
+)
+
+// LoadFixture reads the state at path from the repository's test states, e.g., "states/kubernetes/pod/running.json",
+// and loads it as T like LoadAs. The test fails immediately if the state cannot be read or converted.
+func LoadFixture[T any](t testing.TB, path string) *T {
+	t.Helper()
+
+	data, err := internal.TestStates.ReadFile(path)
+	require.NoError(t, err)
+
+	state, err := LoadAs[T](data)
+	require.NoError(t, err, path)
+
+	return state
+}
+
+// LoadWorkflowFixtures reads the workflows at paths from the repository's test states, and loads their states as T
+// like LoadWorkflowAs, in order. The test fails immediately if a workflow cannot be read or converted.
+func LoadWorkflowFixtures[T any](t testing.TB, paths ...string) []*T {
+	t.Helper()
+
+	var states []*T
+	for _, path := range paths {
+		data, err := internal.TestStates.ReadFile(path)
+		require.NoError(t, err)
+
+		workflow, err := LoadWorkflowAs[T](data)
+		require.NoError(t, err, path)
+		states = append(states, workflow...)
+	}
+
+	return states
+}
@@ -15,54 +15,177 @@
 package test
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
 )
 
-// mustConvertObjToUnstructured converts a raw object to Unstructured and panics on error.
-func mustConvertObjToUnstructured(obj interface{}) *unstructured.Unstructured {
+// convertObjToUnstructured converts a raw object to Unstructured.
+func convertObjToUnstructured(obj interface{}) (*unstructured.Unstructured, error) {
 	jsonBytes, err := json.Marshal(obj)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 	uncastObj, err := runtime.Decode(unstructured.UnstructuredJSONScheme, jsonBytes)
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	if uns, ok := uncastObj.(*unstructured.Unstructured); !ok {
-		panic(fmt.Sprintf("failed to cast obj to Unstructured: %#v", uncastObj))
+		return nil, fmt.Errorf("failed to cast obj to Unstructured: %#v", uncastObj)
 	} else {
-		return uns
+		return uns, nil
 	}
 }
 
-// MustLoadState loads a JSON-encoded k8s event from the provided string, and returns a corresponding
+// LoadState loads a single k8s object from the provided data, and returns a corresponding Unstructured object. The
+// data may be JSON or YAML, and may also be a list, a workflow or a multi-document YAML stream, as long as it contains
+// exactly one object.
+func LoadState(data []byte) (*unstructured.Unstructured, error) {
+	states, err := LoadWorkflow(data)
+	if err != nil {
+		return nil, err
+	}
+	if len(states) != 1 {
+		return nil, fmt.Errorf("expected a single object, got %d", len(states))
+	}
+
+	return states[0], nil
+}
+
+// MustLoadState loads a JSON- or YAML-encoded k8s object from the provided string, and returns a corresponding
 // Unstructured object. This function is intended to be used with vetted test data and will panic on error.
 func MustLoadState(jsonString []byte) *unstructured.Unstructured {
-	var obj interface{}
-	if err := json.Unmarshal(jsonString, &obj); err != nil {
+	state, err := LoadState(jsonString)
+	if err != nil {
 		panic(err)
 	}
 
-	return mustConvertObjToUnstructured(obj)
+	return state
+}
+
+// LoadWorkflow loads a sequence of k8s objects from the provided data, and returns a corresponding slice of
+// Unstructured objects. The data may be any of:
+//   - a JSON array of objects or recorded watch events, as written by the `record` package;
+//   - a stream of JSON objects, such as the output of `kubectl get -w -o json`, with or without
+//     `--output-watch-events`;
+//   - a single or multi-document YAML stream; or
+//   - a List, such as the output of `kubectl get -o json`, whose items are returned in order.
+//
+// Watch events are replaced by their object, and empty YAML documents are skipped.
+func LoadWorkflow(data []byte) ([]*unstructured.Unstructured, error) {
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+
+	var unstructureds []*unstructured.Unstructured
+	for doc := 0; ; doc++ {
+		var obj interface{}
+		if err := decoder.Decode(&obj); err != nil {
+			if errors.Is(err, io.EOF) {
+				return unstructureds, nil
+			}
+			return nil, fmt.Errorf("decoding document %d: %w", doc, err)
+		}
+
+		for i, item := range flatten(obj) {
+			uns, err := convertObjToUnstructured(eventObject(item))
+			if err != nil {
+				return nil, fmt.Errorf("loading object %d of document %d: %w", i, doc, err)
+			}
+			unstructureds = append(unstructureds, uns)
+		}
+	}
 }
 
-// MustLoadWorkflow loads a JSON array of k8s events from the provided string, and returns a corresponding
-// slice of Unstructured objects. This function is intended to be used with vetted test data and will panic on error.
-// Note: The test data can be produced with the `kubespy record` command.
+// MustLoadWorkflow loads a sequence of k8s objects from the provided string in any of the formats accepted by
+// LoadWorkflow, and returns a corresponding slice of Unstructured objects. This function is intended to be used with
+// vetted test data and will panic on error. Note: The test data can be produced with the `record` package or the
+// `kubespy record` command.
 func MustLoadWorkflow(jsonString []byte) []*unstructured.Unstructured {
-	var objects []interface{}
-	if err := json.Unmarshal(jsonString, &objects); err != nil {
+	states, err := LoadWorkflow(jsonString)
+	if err != nil {
 		panic(err)
 	}
-	var unstructureds []*unstructured.Unstructured
-	for _, obj := range objects {
-		unstructureds = append(unstructureds, mustConvertObjToUnstructured(obj))
+
+	return states
+}
+
+// LoadAs loads a single k8s object from the provided data like LoadState, and converts it to T with BuiltInScheme,
+// e.g., LoadAs[corev1.Pod](data).
+func LoadAs[T any](data []byte) (*T, error) {
+	state, err := LoadState(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return convertState[T](state)
+}
+
+// LoadWorkflowAs loads a sequence of k8s objects from the provided data like LoadWorkflow, and converts each of them
+// to T with BuiltInScheme, e.g., LoadWorkflowAs[corev1.Pod](data).
+func LoadWorkflowAs[T any](data []byte) ([]*T, error) {
+	states, err := LoadWorkflow(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var typed []*T
+	for i, state := range states {
+		obj, err := convertState[T](state)
+		if err != nil {
+			return nil, fmt.Errorf("state %d: %w", i, err)
+		}
+		typed = append(typed, obj)
+	}
+
+	return typed, nil
+}
+
+func convertState[T any](state *unstructured.Unstructured) (*T, error) {
+	obj := new(T)
+	if err := BuiltInScheme.Convert(state, obj, nil); err != nil {
+		return nil, fmt.Errorf("converting %s %q: %w", state.GetKind(), state.GetName(), err)
+	}
+
+	return obj, nil
+}
+
+// flatten returns the objects of a decoded document, i.e., the elements of an array, the items of a List, nothing for
+// an empty document, or the document itself.
+func flatten(obj interface{}) []interface{} {
+	switch obj := obj.(type) {
+	case nil:
+		return nil
+	case []interface{}:
+		return obj
+	case map[string]interface{}:
+		kind, _ := obj["kind"].(string)
+		if items, hasItems := obj["items"].([]interface{}); hasItems && strings.HasSuffix(kind, "List") {
+			return items
+		}
+	}
+
+	return []interface{}{obj}
+}
+
+// eventObject returns the object of a recorded watch event, or the provided value if it is not a watch event.
+func eventObject(obj interface{}) interface{} {
+	event, ok := obj.(map[string]interface{})
+	if !ok {
+		return obj
+	}
+	if _, hasType := event["type"].(string); !hasType {
+		return obj
+	}
+	if object, hasObject := event["object"].(map[string]interface{}); hasObject {
+		return object
 	}
 
-	return unstructureds
+	return obj
 }
@@ -0,0 +1,175 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func Test_LoadWorkflow(t *testing.T) {
+	tests := []struct {
+		name       string
+		data       string
+		wantPhases []string
+		wantErr    string
+	}{
+		{
+			name:       "JSON array",
+			data:       `[{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Pending"}}, {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Running"}}]`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name: "JSON watch events",
+			data: `[
+  {"type": "ADDED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Pending"}}},
+  {"type": "MODIFIED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Running"}}}
+]`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name: "kubectl get -w -o json",
+			data: `{
+    "apiVersion": "v1",
+    "kind": "Pod",
+    "metadata": {"name": "foo"},
+    "status": {"phase": "Pending"}
+}
+{
+    "apiVersion": "v1",
+    "kind": "Pod",
+    "metadata": {"name": "foo"},
+    "status": {"phase": "Running"}
+}
+`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name: "kubectl get -w -o json --output-watch-events",
+			data: `{"type": "ADDED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Pending"}}}
+{"type": "MODIFIED", "object": {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Running"}}}
+`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name:       "kubectl get -o json list",
+			data:       `{"apiVersion": "v1", "kind": "List", "items": [{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Pending"}}, {"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Running"}}]}`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name: "single YAML document",
+			data: `apiVersion: v1
+kind: Pod
+metadata:
+  name: foo
+status:
+  phase: Pending
+`,
+			wantPhases: []string{"Pending"},
+		},
+		{
+			name: "multi-document YAML",
+			data: `---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: foo
+status:
+  phase: Pending
+---
+# An empty document is skipped.
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: foo
+status:
+  phase: Running
+`,
+			wantPhases: []string{"Pending", "Running"},
+		},
+		{
+			name:       "empty",
+			data:       "",
+			wantPhases: nil,
+		},
+		{
+			name:    "invalid YAML",
+			data:    "apiVersion: v1\nkind: [Pod\n",
+			wantErr: "decoding document 0",
+		},
+		{
+			name:    "invalid JSON stream",
+			data:    `{"apiVersion": "v1", "kind": "Pod"} {"apiVersion": `,
+			wantErr: "decoding document 1",
+		},
+		{
+			name:    "missing kind",
+			data:    `{"apiVersion": "v1", "metadata": {"name": "foo"}}`,
+			wantErr: "loading object 0 of document 0",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			states, err := LoadWorkflow([]byte(tt.data))
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantPhases, phases(states))
+		})
+	}
+}
+
+func Test_LoadState(t *testing.T) {
+	state, err := LoadState([]byte("apiVersion: v1\nkind: Pod\nmetadata:\n  name: foo\n"))
+	require.NoError(t, err)
+	assert.Equal(t, "foo", state.GetName())
+
+	_, err = LoadState([]byte("apiVersion: v1\nkind: Pod\n---\napiVersion: v1\nkind: Pod\n"))
+	assert.EqualError(t, err, "expected a single object, got 2")
+
+	assert.Panics(t, func() { MustLoadState([]byte("[]")) })
+}
+
+func Test_LoadAs(t *testing.T) {
+	pod, err := LoadAs[corev1.Pod]([]byte(`{"apiVersion": "v1", "kind": "Pod", "metadata": {"name": "foo"}, "status": {"phase": "Running"}}`))
+	require.NoError(t, err)
+	assert.Equal(t, "foo", pod.Name)
+	assert.Equal(t, corev1.PodRunning, pod.Status.Phase)
+
+	_, err = LoadAs[corev1.Pod]([]byte(`{"apiVersion": "v1", "kind": "Service", "metadata": {"name": "foo"}}`))
+	assert.ErrorContains(t, err, `converting Service "foo"`)
+
+	pods, err := LoadWorkflowAs[corev1.Pod]([]byte("kind: Pod\napiVersion: v1\nstatus:\n  phase: Pending\n---\nkind: Pod\napiVersion: v1\nstatus:\n  phase: Running\n"))
+	require.NoError(t, err)
+	require.Len(t, pods, 2)
+	assert.Equal(t, corev1.PodPending, pods[0].Status.Phase)
+	assert.Equal(t, corev1.PodRunning, pods[1].Status.Phase)
+}
+
+func phases(states []*unstructured.Unstructured) []string {
+	var phases []string
+	for _, state := range states {
+		phase, _, _ := unstructured.NestedString(state.Object, "status", "phase")
+		phases = append(phases, phase)
+	}
+	return phases
+}
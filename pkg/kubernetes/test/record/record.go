@@ -0,0 +1,244 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package record records Kubernetes watch streams as workflow fixtures that can be loaded with
+// test.MustLoadWorkflow.
+package record
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+// Event is a recorded watch event.
+type Event struct {
+	Type      watch.EventType            `json:"type"`      // ADDED, MODIFIED or DELETED.
+	Timestamp time.Time                  `json:"timestamp"` // The time the event was received.
+	Object    *unstructured.Unstructured `json:"object"`    // The object, as of the event.
+}
+
+// Args configures the Recorder returned by NewRecorder.
+type Args struct {
+	// Scheme is used to set the apiVersion and kind of typed objects that don't include them, such as the objects
+	// returned by fake clientsets. Defaults to test.BuiltInScheme.
+	Scheme *runtime.Scheme
+	// Now returns the timestamp of each event. Defaults to time.Now.
+	Now func() time.Time
+	// Until stops the recording once it returns true for a recorded event, e.g., once the object is ready. By default
+	// the recording continues until the watch ends or the context is done.
+	Until func(event Event) bool
+}
+
+// Recorder records the events of one or more watch streams.
+type Recorder struct {
+	scheme *runtime.Scheme
+	now    func() time.Time
+	until  func(event Event) bool
+
+	mu     sync.Mutex
+	events []Event
+}
+
+// NewRecorder returns a Recorder configured with the provided args.
+func NewRecorder(args *Args) *Recorder {
+	r := &Recorder{scheme: test.BuiltInScheme, now: time.Now}
+	if args != nil {
+		if args.Scheme != nil {
+			r.scheme = args.Scheme
+		}
+		if args.Now != nil {
+			r.now = args.Now
+		}
+		r.until = args.Until
+	}
+
+	return r
+}
+
+// Record records the events of the watch until the watch ends, the Until func returns true, or the context is done.
+// The watch is stopped before Record returns. Bookmark events are skipped, and an Error event ends the recording with
+// an error. It returns the context's error if the context is done first.
+func (r *Recorder) Record(ctx context.Context, w watch.Interface) error {
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return context.Cause(ctx)
+		case event, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+			done, err := r.add(event.Type, event.Object)
+			if err != nil || done {
+				return err
+			}
+		}
+	}
+}
+
+// RecordStream records the events of a watch response body, i.e., a stream of JSON-encoded metav1.WatchEvents, such
+// as the body of `GET /api/v1/namespaces/default/pods?watch=true`. It returns when the stream ends, the Until func
+// returns true, or the context is done. The context is only checked between events, so a stream that is waiting for
+// events should be stopped by cancelling the request. The caller is responsible for closing the body.
+func (r *Recorder) RecordStream(ctx context.Context, body io.Reader) error {
+	decoder := json.NewDecoder(body)
+	for {
+		if err := ctx.Err(); err != nil {
+			return context.Cause(ctx)
+		}
+
+		var event metav1.WatchEvent
+		if err := decoder.Decode(&event); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("decoding watch event: %w", err)
+		}
+
+		obj := &unstructured.Unstructured{}
+		if err := obj.UnmarshalJSON(event.Object.Raw); err != nil {
+			return fmt.Errorf("decoding %s event object: %w", event.Type, err)
+		}
+		done, err := r.add(watch.EventType(event.Type), obj)
+		if err != nil || done {
+			return err
+		}
+	}
+}
+
+// Events returns the events recorded so far.
+func (r *Recorder) Events() []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]Event(nil), r.events...)
+}
+
+// Write writes the recorded events to w as an indented JSON array, in the format of the fixtures in
+// internal/workflows.
+func (r *Recorder) Write(w io.Writer) error {
+	return Write(w, r.Events())
+}
+
+// WriteFile writes the recorded events to the named file, creating or truncating it.
+func (r *Recorder) WriteFile(path string) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+
+	err = r.Write(f)
+	return errors.Join(err, f.Close())
+}
+
+// Write writes events to w as an indented JSON array, in the format of the fixtures in internal/workflows.
+func Write(w io.Writer, events []Event) error {
+	if events == nil {
+		events = []Event{}
+	}
+
+	jsonBytes, err := json.MarshalIndent(events, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(append(jsonBytes, '\n'))
+	return err
+}
+
+// Read decodes events that were written by Write.
+func Read(jsonBytes []byte) ([]Event, error) {
+	var events []Event
+	if err := json.Unmarshal(jsonBytes, &events); err != nil {
+		return nil, err
+	}
+	for i, event := range events {
+		if event.Object == nil {
+			return nil, fmt.Errorf("event %d (%s) has no object", i, event.Type)
+		}
+	}
+
+	return events, nil
+}
+
+// add records an event, and returns true if the recording should stop.
+func (r *Recorder) add(eventType watch.EventType, obj runtime.Object) (bool, error) {
+	switch eventType {
+	case watch.Bookmark:
+		return false, nil
+	case watch.Error:
+		return true, watchError(obj)
+	}
+
+	uns, err := r.toUnstructured(obj)
+	if err != nil {
+		return true, fmt.Errorf("recording %s event: %w", eventType, err)
+	}
+	// Managed fields are not used by the checkers and make the fixtures harder to read.
+	uns.SetManagedFields(nil)
+
+	event := Event{Type: eventType, Timestamp: r.now().UTC(), Object: uns}
+	r.mu.Lock()
+	r.events = append(r.events, event)
+	r.mu.Unlock()
+
+	return r.until != nil && r.until(event), nil
+}
+
+func (r *Recorder) toUnstructured(obj runtime.Object) (*unstructured.Unstructured, error) {
+	if uns, ok := obj.(*unstructured.Unstructured); ok {
+		return uns.DeepCopy(), nil
+	}
+
+	content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return nil, err
+	}
+	uns := &unstructured.Unstructured{Object: content}
+
+	if uns.GetKind() == "" {
+		gvks, _, err := r.scheme.ObjectKinds(obj)
+		if err != nil {
+			return nil, err
+		}
+		uns.SetGroupVersionKind(gvks[0])
+	}
+
+	return uns, nil
+}
+
+func watchError(obj runtime.Object) error {
+	switch status := obj.(type) {
+	case *metav1.Status:
+		return fmt.Errorf("watch error: %s", status.Message)
+	case *unstructured.Unstructured:
+		if msg, found, _ := unstructured.NestedString(status.Object, "message"); found {
+			return fmt.Errorf("watch error: %s", msg)
+		}
+	}
+
+	return fmt.Errorf("watch error: %v", obj)
+}
@@ -0,0 +1,188 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package record
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/pod"
+	"github.com/pulumi/cloud-ready-checks/pkg/kubernetes/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+)
+
+func Test_Record(t *testing.T) {
+	w := watch.NewFake()
+	go func() {
+		created := newPod(corev1.PodPending, corev1.ConditionFalse)
+		created.ManagedFields = []metav1.ManagedFieldsEntry{{Manager: "kubectl", Operation: metav1.ManagedFieldsOperationApply}}
+		w.Add(created)
+		w.Action(watch.Bookmark, newPod(corev1.PodPending, corev1.ConditionFalse))
+		w.Modify(newPod(corev1.PodRunning, corev1.ConditionTrue))
+		w.Delete(newPod(corev1.PodRunning, corev1.ConditionTrue))
+		w.Stop()
+	}()
+
+	recorder := NewRecorder(&Args{Now: clock()})
+	require.NoError(t, recorder.Record(context.Background(), w))
+
+	events := recorder.Events()
+	require.Len(t, events, 3)
+	assert.Equal(t, []watch.EventType{watch.Added, watch.Modified, watch.Deleted}, eventTypes(events))
+	assert.Equal(t, time.Date(2026, 3, 20, 8, 0, 1, 0, time.UTC), events[0].Timestamp)
+	assert.Equal(t, time.Date(2026, 3, 20, 8, 0, 3, 0, time.UTC), events[2].Timestamp)
+	assert.Equal(t, "Pod", events[0].Object.GetKind())
+	assert.Equal(t, "v1", events[0].Object.GetAPIVersion())
+	assert.Empty(t, events[0].Object.GetManagedFields())
+
+	var buf bytes.Buffer
+	require.NoError(t, recorder.Write(&buf))
+	read, err := Read(buf.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, events, read)
+
+	// The recording can be replayed like any other workflow.
+	pods, err := test.LoadWorkflowAs[corev1.Pod](buf.Bytes())
+	require.NoError(t, err)
+	result := checker.Await(context.Background(), pod.NewPodChecker(), test.Stream(pods))
+	assert.True(t, result.Ready())
+}
+
+func Test_Record_Until(t *testing.T) {
+	w := watch.NewFakeWithChanSize(3, false)
+	w.Add(newPod(corev1.PodPending, corev1.ConditionFalse))
+	w.Modify(newPod(corev1.PodRunning, corev1.ConditionTrue))
+	w.Delete(newPod(corev1.PodRunning, corev1.ConditionTrue))
+
+	podChecker := pod.NewPodChecker()
+	recorder := NewRecorder(&Args{Until: func(event Event) bool {
+		p := corev1.Pod{}
+		require.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(event.Object.Object, &p))
+		return podChecker.Ready(&p)
+	}})
+	require.NoError(t, recorder.Record(context.Background(), w))
+
+	assert.Equal(t, []watch.EventType{watch.Added, watch.Modified}, eventTypes(recorder.Events()))
+	assert.True(t, w.IsStopped())
+}
+
+func Test_Record_Error(t *testing.T) {
+	w := watch.NewFakeWithChanSize(2, false)
+	w.Add(newPod(corev1.PodPending, corev1.ConditionFalse))
+	w.Error(&metav1.Status{Status: metav1.StatusFailure, Message: "too old resource version: 1 (2)"})
+
+	recorder := NewRecorder(nil)
+	err := recorder.Record(context.Background(), w)
+	assert.EqualError(t, err, "watch error: too old resource version: 1 (2)")
+	assert.Len(t, recorder.Events(), 1)
+}
+
+func Test_Record_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	recorder := NewRecorder(nil)
+	err := recorder.Record(ctx, watch.NewFake())
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Empty(t, recorder.Events())
+}
+
+func Test_RecordStream(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/api/v1/namespaces/default/pods", r.URL.Path)
+		assert.Equal(t, "true", r.URL.Query().Get("watch"))
+
+		encoder := json.NewEncoder(w)
+		for _, event := range []struct {
+			eventType watch.EventType
+			obj       *corev1.Pod
+		}{
+			{watch.Added, newPod(corev1.PodPending, corev1.ConditionFalse)},
+			{watch.Modified, newPod(corev1.PodRunning, corev1.ConditionTrue)},
+		} {
+			event.obj.APIVersion, event.obj.Kind = "v1", "Pod"
+			raw, err := json.Marshal(event.obj)
+			require.NoError(t, err)
+			require.NoError(t, encoder.Encode(metav1.WatchEvent{
+				Type:   string(event.eventType),
+				Object: runtime.RawExtension{Raw: raw},
+			}))
+			w.(http.Flusher).Flush()
+		}
+	}))
+	defer server.Close()
+
+	resp, err := http.Get(server.URL + "/api/v1/namespaces/default/pods?watch=true")
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	recorder := NewRecorder(&Args{Now: clock()})
+	require.NoError(t, recorder.RecordStream(context.Background(), resp.Body))
+
+	events := recorder.Events()
+	assert.Equal(t, []watch.EventType{watch.Added, watch.Modified}, eventTypes(events))
+	assert.Equal(t, "Running", events[1].Object.Object["status"].(map[string]interface{})["phase"])
+
+	path := filepath.Join(t.TempDir(), "pod.json")
+	require.NoError(t, recorder.WriteFile(path))
+}
+
+//
+// Helpers
+//
+
+// clock returns a Now func that advances by one second on each call.
+func clock() func() time.Time {
+	now := time.Date(2026, 3, 20, 8, 0, 0, 0, time.UTC)
+	return func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+}
+
+func eventTypes(events []Event) []watch.EventType {
+	var types []watch.EventType
+	for _, event := range events {
+		types = append(types, event.Type)
+	}
+	return types
+}
+
+func newPod(phase corev1.PodPhase, ready corev1.ConditionStatus) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", UID: "1d2e3f4a-5b6c-4d7e-8f9a-0b1c2d3e4f5a"},
+		Spec:       corev1.PodSpec{Containers: []corev1.Container{{Name: "nginx", Image: "nginx:1.27-alpine"}}},
+		Status: corev1.PodStatus{
+			Phase: phase,
+			Conditions: []corev1.PodCondition{
+				{Type: corev1.PodScheduled, Status: corev1.ConditionTrue},
+				{Type: corev1.PodInitialized, Status: corev1.ConditionTrue},
+				{Type: corev1.PodReady, Status: ready},
+			},
+		},
+	}
+}
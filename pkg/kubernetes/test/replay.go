@@ -0,0 +1,138 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// UpdateGoldenEnv is the environment variable that makes AssertGolden rewrite the golden files instead of comparing
+// them, e.g., `UPDATE_GOLDEN=1 go test ./pkg/kubernetes/pod`.
+const UpdateGoldenEnv = "UPDATE_GOLDEN"
+
+// UpdateGoldenFlag is the name of the boolean test flag that also makes AssertGolden rewrite the golden files, e.g.,
+// `go test ./pkg/kubernetes/pod -update`. This package doesn't define the flag, since that would add it to every
+// binary that imports the package; test packages that use golden files define it in a _test.go file:
+//
+//	var _ = flag.Bool(test.UpdateGoldenFlag, false, "update the golden files of replayed workflows")
+const UpdateGoldenFlag = "update"
+
+// Timeline is the result of replaying a workflow with Replay.
+type Timeline struct {
+	Steps  []checker.Results   // The Results for each state evaluated, in order.
+	Result checker.AwaitResult // The result of the Await call.
+}
+
+// String formats the Results of each step followed by the outcome, e.g.,
+//
+//	# state 1
+//	["pending"] Waiting for Pod "foo" to be scheduled
+//	# state 2
+//	["done"] Waiting for Pod "foo" to be scheduled
+//	...
+//	# outcome: ready
+func (t Timeline) String() string {
+	var b strings.Builder
+	for i, results := range t.Steps {
+		fmt.Fprintf(&b, "# state %d\n", i+1)
+		for _, result := range results {
+			b.WriteString(strings.TrimSuffix(result.String(), "\n"))
+			b.WriteString("\n")
+		}
+	}
+
+	fmt.Fprintf(&b, "# outcome: %s", t.Result.Outcome)
+	if t.Result.Err != nil {
+		fmt.Fprintf(&b, " (%s)", t.Result.Err)
+	}
+	b.WriteString("\n")
+
+	return b.String()
+}
+
+// Replay feeds the states to the provided StateChecker with checker.Await, and records the Results for each state
+// that is evaluated. Like Await, it stops at the first state that is Ready or has failed, so the states after that
+// are not part of the timeline.
+func Replay[T any](c *checker.StateChecker[T], states []T) Timeline {
+	var timeline Timeline
+	timeline.Result = checker.Await(context.Background(), c, Stream(states), func(results checker.Results) {
+		timeline.Steps = append(timeline.Steps, results)
+	})
+
+	return timeline
+}
+
+// AssertReplay replays the states with the provided StateChecker, and compares the timeline with the golden file for
+// the test. Like Replay, the timeline ends at the first state that is Ready or has failed. It returns the timeline for further assertions.
+func AssertReplay[T any](t testing.TB, c *checker.StateChecker[T], states []T) Timeline {
+	t.Helper()
+
+	timeline := Replay(c, states)
+	AssertGolden(t, GoldenPath(t), timeline.String())
+
+	return timeline
+}
+
+// AssertGolden compares got with the contents of the golden file at path. If UpdateGoldenEnv is set to a true value,
+// or the UpdateGoldenFlag test flag is set, the golden file is written instead.
+func AssertGolden(t testing.TB, path string, got string) {
+	t.Helper()
+
+	if updateGolden() {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(got), 0o644))
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	require.NoError(t, err, "missing golden file; run the test with -%s to create it", UpdateGoldenFlag)
+	assert.Equal(t, string(want), got, "golden file %s is out of date; run the test with -%s to update it",
+		path, UpdateGoldenFlag)
+}
+
+// updateGolden returns whether the UpdateGoldenFlag test flag is defined and set, or UpdateGoldenEnv is set to a true
+// value, as accepted by strconv.ParseBool.
+func updateGolden() bool {
+	if f := flag.Lookup(UpdateGoldenFlag); f != nil {
+		if getter, ok := f.Value.(flag.Getter); ok {
+			if update, ok := getter.Get().(bool); ok && update {
+				return true
+			}
+		}
+	}
+
+	update, _ := strconv.ParseBool(os.Getenv(UpdateGoldenEnv))
+	return update
+}
+
+var goldenNameRegexp = regexp.MustCompile(`[^A-Za-z0-9_\-/]+`)
+
+// GoldenPath returns the path of the golden file for the test, i.e., testdata/<test name>.golden relative to the test's
+// package. Characters other than letters, digits, "-" and "_" in the name of the test and its subtests are replaced.
+func GoldenPath(t testing.TB) string {
+	return filepath.Join("testdata", goldenNameRegexp.ReplaceAllString(t.Name(), "_")+".golden")
+}
@@ -0,0 +1,124 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/pulumi/cloud-ready-checks/pkg/checker"
+	"github.com/pulumi/cloud-ready-checks/pkg/checker/logging"
+	"github.com/stretchr/testify/assert"
+)
+
+// The -update flag rewrites the golden files of the replayed workflows, e.g., `go test ./pkg/kubernetes/test -update`.
+var _ = flag.Bool(UpdateGoldenFlag, false, "update the golden files of replayed workflows")
+
+// countChecker waits for a count to reach 3, and fails permanently for negative counts.
+func countChecker() *checker.StateChecker[int] {
+	return checker.NewStateChecker(&checker.StateCheckerArgs[int]{
+		Conditions: []checker.Condition[int]{
+			func(count int) checker.Result {
+				result := checker.Result{Description: "Waiting for the count to be positive"}
+				switch {
+				case count < 0:
+					result.Failed = true
+					result.Message = logging.ErrorMessage(fmt.Sprintf("count is %d", count))
+				case count > 0:
+					result.Ok = true
+				}
+				return result
+			},
+			func(count int) checker.Result {
+				return checker.Result{Description: fmt.Sprintf("Waiting for the count to reach 3 (%d/3)", count), Ok: count >= 3}
+			},
+		},
+	})
+}
+
+func Test_Replay(t *testing.T) {
+	tests := []struct {
+		name   string
+		states []int
+		want   string
+	}{
+		{
+			name:   "ready",
+			states: []int{0, 2, 3, 4},
+			want: `# state 1
+["pending"] Waiting for the count to be positive
+# state 2
+["done"] Waiting for the count to be positive
+["pending"] Waiting for the count to reach 3 (2/3)
+# state 3
+["done"] Waiting for the count to be positive
+["done"] Waiting for the count to reach 3 (3/3)
+# outcome: ready
+`,
+		},
+		{
+			name:   "failed",
+			states: []int{1, -1},
+			want: `# state 1
+["done"] Waiting for the count to be positive
+["pending"] Waiting for the count to reach 3 (1/3)
+# state 2
+["failed"] Waiting for the count to be positive -- count is -1
+# outcome: failed (Waiting for the count to be positive: count is -1)
+`,
+		},
+		{
+			name:   "no more states",
+			states: nil,
+			want: `# outcome: failed (no more states to check)
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeline := Replay(countChecker(), tt.states)
+			assert.Equal(t, tt.want, timeline.String())
+		})
+	}
+}
+
+func Test_AssertReplay(t *testing.T) {
+	timeline := AssertReplay(t, countChecker(), []int{1, 2, 3})
+	assert.True(t, timeline.Result.Ready())
+	assert.Len(t, timeline.Steps, 3)
+}
+
+func Test_AssertGolden_Update(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "testdata", "update.golden")
+
+	t.Setenv(UpdateGoldenEnv, "true")
+	AssertGolden(t, path, "# outcome: ready\n")
+
+	written, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Equal(t, "# outcome: ready\n", string(written))
+
+	t.Setenv(UpdateGoldenEnv, "")
+	AssertGolden(t, path, "# outcome: ready\n")
+}
+
+func Test_GoldenPath(t *testing.T) {
+	t.Run("restartPolicy: Never", func(t *testing.T) {
+		assert.Equal(t, "testdata/Test_GoldenPath/restartPolicy__Never.golden", GoldenPath(t))
+	})
+}
@@ -0,0 +1,27 @@
+// Copyright 2016-2026, Pulumi Corporation.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package test
+
+// Stream returns a closed channel that yields the provided states in order. It is intended to feed recorded
+// workflows to checker.Await.
+func Stream[T any](states []T) <-chan T {
+	ch := make(chan T, len(states))
+	for _, state := range states {
+		ch <- state
+	}
+	close(ch)
+
+	return ch
+}
@@ -15,7 +15,12 @@
 package kubernetes
 
 import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // FullyQualifiedName returns the fully qualified name of the object in the form `[namespace]/name`.
@@ -27,3 +32,58 @@ func FullyQualifiedName(obj metav1.Object) string {
 	}
 	return obj.GetName()
 }
+
+// IsControlledBy returns whether the controller owner reference of obj refers to owner. Unlike metav1.IsControlledBy,
+// it is false if owner has no UID yet, so that objects without a controller are never matched.
+func IsControlledBy(obj metav1.Object, owner metav1.Object) bool {
+	if len(owner.GetUID()) == 0 {
+		return false
+	}
+
+	ref := metav1.GetControllerOfNoCopy(obj)
+	return ref != nil && ref.UID == owner.GetUID()
+}
+
+// ConditionMessage formats the reason and message of a status condition as "[Reason] message", omitting whichever of
+// them is empty.
+func ConditionMessage(reason, message string) string {
+	switch {
+	case len(reason) > 0 && len(message) > 0:
+		return fmt.Sprintf("[%s] %s", reason, message)
+	case len(reason) > 0:
+		return fmt.Sprintf("[%s]", reason)
+	default:
+		return message
+	}
+}
+
+// IsSidecar returns true if the container is a native sidecar, i.e., an init container with restartPolicy: Always that
+// keeps running alongside the Pod's containers.
+func IsSidecar(container *corev1.Container) bool {
+	return container != nil && container.RestartPolicy != nil &&
+		*container.RestartPolicy == corev1.ContainerRestartPolicyAlways
+}
+
+// FromUnstructured converts an untyped state to a typed object of type T. States that are already a *T are returned
+// as is, and *unstructured.Unstructured states are converted field by field. It is intended to be used as a
+// checker.Converter for callers that still pass states as interface{}.
+func FromUnstructured[T any](state interface{}) (*T, error) {
+	switch obj := state.(type) {
+	case *T:
+		if obj == nil {
+			return nil, fmt.Errorf("unexpected nil state of type %T", state)
+		}
+		return obj, nil
+	case *unstructured.Unstructured:
+		if obj == nil {
+			return nil, fmt.Errorf("unexpected nil state of type %T", state)
+		}
+		typed := new(T)
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), typed); err != nil {
+			return nil, err
+		}
+		return typed, nil
+	default:
+		return nil, fmt.Errorf("unexpected state type: got %T, want %T", state, new(T))
+	}
+}